@@ -1,12 +1,36 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"context"
+	"flag"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/vultr/terraform-provider-vultr/vultr"
+	"github.com/vultr/terraform-provider-vultr/vultr/fwprovider"
 )
 
+// version is set by goreleaser
+var version = "dev"
+
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: vultr.Provider,
-	})
+	var debug bool
+	flag.BoolVar(&debug, "debug", false, "run the provider with support for debuggers like delve")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	server, err := fwprovider.ProviderServer(ctx, vultr.Provider(), version)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var opts []tf6server.ServeOpt
+	if debug {
+		opts = append(opts, tf6server.WithManagedDebug())
+	}
+
+	if err := tf6server.Serve("registry.terraform.io/vultr/vultr", server, opts...); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -0,0 +1,114 @@
+package vultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/terraform-provider-vultr/vultr/internal/cassette"
+)
+
+// testAccCassette runs an acceptance test against a recorded cassette in
+// testdata/cassettes when VULTR_CASSETTE_MODE is "record" or "replay".
+// Without it the test talks to the API through the shared testAccProvider.
+type testAccCassette struct {
+	t        *testing.T
+	cassette *cassette.Cassette
+	provider *schema.Provider
+	rand     *rand.Rand
+}
+
+func newTestAccCassette(t *testing.T) *testAccCassette {
+	t.Helper()
+
+	c := &testAccCassette{t: t, provider: testAccProvider}
+
+	v := os.Getenv("VULTR_CASSETTE_MODE")
+	if v == "" {
+		return c
+	}
+
+	mode, err := cassette.ParseMode(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join("testdata", "cassettes", t.Name()+".json")
+	c.cassette, err = cassette.Open(path, mode)
+	if errors.Is(err, cassette.ErrNotFound) {
+		t.Skipf("no cassette recorded at %s", path)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.rand = c.cassette.Rand()
+	c.provider = Provider()
+	c.provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		config := providerConfig(d)
+		config.Cassette = c.cassette
+		return configureClient(ctx, config)
+	}
+
+	if mode == cassette.ModeReplay {
+		// The recorded requests have their credentials stripped, so any key
+		// will do when replaying.
+		c.provider.Schema["api_key"].DefaultFunc = schema.EnvDefaultFunc("VULTR_API_KEY", cassette.Redacted)
+	}
+
+	if mode == cassette.ModeRecord {
+		t.Cleanup(func() {
+			if t.Failed() {
+				t.Logf("not saving cassette %s for a failed test", path)
+				return
+			}
+			if err := c.cassette.Save(); err != nil {
+				t.Errorf("error saving cassette: %v", err)
+			}
+		})
+	}
+
+	return c
+}
+
+// PreCheck is the cassette aware replacement for testAccPreCheck
+func (c *testAccCassette) PreCheck() {
+	if c.cassette != nil && c.cassette.Mode() == cassette.ModeReplay {
+		return
+	}
+	testAccPreCheck(c.t)
+}
+
+// ProviderFactories returns the factories resource.TestCase should use
+func (c *testAccCassette) ProviderFactories() map[string]func() (*schema.Provider, error) {
+	if c.cassette == nil {
+		return testAccProviderFactories
+	}
+
+	return map[string]func() (*schema.Provider, error){
+		"vultr": func() (*schema.Provider, error) {
+			return c.provider, nil
+		},
+	}
+}
+
+// Provider is the configured provider the test's checks should query
+func (c *testAccCassette) Provider() *schema.Provider {
+	return c.provider
+}
+
+// RandomWithPrefix matches acctest.RandomWithPrefix but is seeded from the
+// cassette so names line up with the recorded requests.
+func (c *testAccCassette) RandomWithPrefix(prefix string) string {
+	if c.rand == nil {
+		return acctest.RandomWithPrefix(prefix)
+	}
+	return fmt.Sprintf("%s-%d", prefix, c.rand.Int())
+}
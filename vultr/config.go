@@ -2,12 +2,21 @@ package vultr
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
 	"runtime/debug"
+	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/vultr/govultr/v3"
+	"github.com/vultr/terraform-provider-vultr/vultr/internal/cassette"
 	"golang.org/x/oauth2"
 )
 
@@ -15,20 +24,59 @@ const terraformSDKPath string = "github.com/hashicorp/terraform-plugin-sdk/v2"
 
 // Config is the configuration structure used to instantiate Vultr
 type Config struct {
-	APIKey     string
-	RateLimit  int
-	RetryLimit int
+	APIKey      string
+	APIEndpoint string
+	RateLimit   int
+	RetryLimit  int
+
+	// APIKeyFile and APIKeyCommand are re-read periodically in place of a
+	// static APIKey
+	APIKeyFile    string
+	APIKeyCommand string
+
+	// HTTPProxy, CABundleFile, InsecureSkipVerify and RequestTimeout (in
+	// seconds) configure the transport underneath the logging transport
+	HTTPProxy          string
+	CABundleFile       string
+	InsecureSkipVerify bool
+	RequestTimeout     int
+
+	// MaxRetryWait is the longest, in seconds, to wait between retries
+	MaxRetryWait int
+
+	// RetryPolicy overrides the default backoff built from the fields above
+	RetryPolicy RetryPolicy
+
+	// DefaultTags are added to every taggable resource
+	DefaultTags []string
+
+	// IgnoreTags are tags managed outside of Terraform
+	IgnoreTags *ignoreTagsConfig
+
+	// SkipCredentialsValidation disables the account lookup done at configure time
+	SkipCredentialsValidation bool
+
+	// Cassette records or replays the API traffic of an acceptance test
+	Cassette *cassette.Cassette
 }
 
 // Client wraps govultr
 type Client struct {
-	client *govultr.Client
+	client      *govultr.Client
+	defaultTags []string
+	ignoreTags  *ignoreTagsConfig
 }
 
 func (c *Client) govultrClient() *govultr.Client {
 	return c.client
 }
 
+// Govultr returns the underlying govultr client for the framework provider,
+// which lives outside this package
+func (c *Client) Govultr() *govultr.Client {
+	return c.client
+}
+
 // terraformSDKVersion looks up the module version of the Terraform SDK for use
 // in the User Agent client string
 func terraformSDKVersion() string {
@@ -49,23 +97,233 @@ func terraformSDKVersion() string {
 // Client configures govultr and returns an initialized client
 func (c *Config) Client() (*Client, error) {
 	userAgent := fmt.Sprintf("Terraform/%s", terraformSDKVersion())
-	tokenSrc := oauth2.StaticTokenSource(&oauth2.Token{
-		AccessToken: c.APIKey,
-	})
+	tokenSrc, err := c.tokenSource()
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the key once up front so a missing file or failing command is
+	// reported at configure time
+	if _, err := tokenSrc.Token(); err != nil {
+		return nil, err
+	}
+
+	transport, err := c.httpTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: transport})
+	client := oauth2.NewClient(ctx, tokenSrc)
+	client.Transport = newAPILoggingTransport(client.Transport)
 
-	client := oauth2.NewClient(context.Background(), tokenSrc)
-	client.Transport = logging.NewSubsystemLoggingHTTPTransport("Vultr", client.Transport)
+	if c.Cassette != nil {
+		client.Transport = c.Cassette.Transport(client.Transport)
+	}
+
+	client.Transport = newRetryTransport(client.Transport, c.retryPolicy(), defaultEndpointConcurrency)
 
 	vultrClient := govultr.NewClient(client)
 	vultrClient.SetUserAgent(userAgent)
 
-	if c.RateLimit != 0 {
-		vultrClient.SetRateLimit(time.Duration(c.RateLimit) * time.Millisecond)
+	// Retries are handled by the transport, which unlike govultr's own
+	// retries honours Retry-After and caps concurrent requests
+	vultrClient.SetRetryLimit(0)
+
+	if c.APIEndpoint != "" {
+		if err := validateAPIEndpoint(c.APIEndpoint); err != nil {
+			return nil, err
+		}
+
+		if err := vultrClient.SetBaseURL(c.APIEndpoint); err != nil {
+			return nil, fmt.Errorf("error setting api_endpoint %q: %v", c.APIEndpoint, err)
+		}
+	}
+
+	return &Client{
+		client:      vultrClient,
+		defaultTags: c.DefaultTags,
+		ignoreTags:  c.IgnoreTags,
+	}, nil
+}
+
+// httpTransport builds the base transport with the configured proxy, CA
+// bundle and timeouts
+func (c *Config) httpTransport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if c.HTTPProxy != "" {
+		proxy, err := url.Parse(c.HTTPProxy)
+		if err != nil || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid http_proxy %q", c.HTTPProxy)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	if c.CABundleFile != "" || c.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			// Only meant for talking to fakes with self-signed certificates
+			InsecureSkipVerify: c.InsecureSkipVerify, //nolint:gosec
+		}
+	}
+
+	if c.CABundleFile != "" {
+		pem, err := os.ReadFile(c.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ca_bundle_file: %v", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("ca_bundle_file %s does not contain any PEM encoded certificates", c.CABundleFile)
+		}
+
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if c.RequestTimeout != 0 {
+		timeout := time.Duration(c.RequestTimeout) * time.Second
+		transport.DialContext = (&net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second}).DialContext
+		transport.TLSHandshakeTimeout = timeout
+		transport.ResponseHeaderTimeout = timeout
+	}
+
+	return transport, nil
+}
+
+// tokenSource returns where the API key comes from. A file or command takes
+// precedence over a static key, which may have come from VULTR_API_KEY.
+func (c *Config) tokenSource() (oauth2.TokenSource, error) {
+	switch {
+	case c.APIKeyFile != "" && c.APIKeyCommand != "":
+		return nil, fmt.Errorf("only one of api_key_file or api_key_command can be set")
+	case c.APIKeyCommand != "":
+		return newAPIKeyCommandTokenSource(c.APIKeyCommand, apiKeyRefreshInterval), nil
+	case c.APIKeyFile != "":
+		return newAPIKeyFileTokenSource(c.APIKeyFile, apiKeyRefreshInterval), nil
+	case c.APIKey != "":
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: c.APIKey}), nil
+	default:
+		return nil, fmt.Errorf("one of api_key, api_key_file or api_key_command must be set")
+	}
+}
+
+// apiKeyAttribute is the provider argument the API key was read from
+func (c *Config) apiKeyAttribute() string {
+	switch {
+	case c.APIKeyCommand != "":
+		return "api_key_command"
+	case c.APIKeyFile != "":
+		return "api_key_file"
+	default:
+		return "api_key"
+	}
+}
+
+// retryPolicy returns the configured RetryPolicy or builds the default
+// backoff from rate_limit, retry_limit and max_retry_wait
+func (c *Config) retryPolicy() RetryPolicy {
+	if c.RetryPolicy != nil {
+		return c.RetryPolicy
+	}
+
+	policy := &BackoffRetryPolicy{
+		MaxRetries: defaultRetryLimit,
+		MinWait:    defaultRetryMinWait,
+		MaxWait:    defaultMaxRetryWait,
 	}
 
 	if c.RetryLimit != 0 {
-		vultrClient.SetRetryLimit(c.RetryLimit)
+		policy.MaxRetries = c.RetryLimit
+	}
+
+	if c.RateLimit != 0 {
+		policy.MinWait = time.Duration(c.RateLimit) * time.Millisecond
 	}
 
-	return &Client{client: vultrClient}, nil
+	if c.MaxRetryWait != 0 {
+		policy.MaxWait = time.Duration(c.MaxRetryWait) * time.Second
+	}
+
+	return policy
+}
+
+// validateAPIEndpoint ensures the endpoint is an absolute http(s) URL. The
+// govultr request paths already carry the API version so the endpoint should
+// only contain the scheme, host and an optional path prefix.
+func validateAPIEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid api_endpoint %q: %v", endpoint, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid api_endpoint %q: scheme must be http or https", endpoint)
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("invalid api_endpoint %q: missing host", endpoint)
+	}
+
+	return nil
+}
+
+// skipCredentialsHint is appended to credential errors so users planning
+// without network access know how to bypass the check
+const skipCredentialsHint = "Set skip_credentials_validation = true (or VULTR_SKIP_CREDENTIALS_VALIDATION) to skip this check, for example for offline plans."
+
+// validateCredentials fetches the account once so that a bad or
+// under-privileged API key is reported against api_key at configure time
+// rather than as an obscure error from the first resource that uses it.
+func validateCredentials(ctx context.Context, client *Client, attribute string) diag.Diagnostics {
+	_, resp, err := client.govultrClient().Account.Get(ctx)
+	if err == nil {
+		return nil
+	}
+
+	apiKey := cty.GetAttrPath(attribute)
+	status := apiErrorStatus(resp, err)
+
+	if status == 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to reach the Vultr API",
+			Detail:   fmt.Sprintf("The API key could not be validated: %v\n\n%s", err, skipCredentialsHint),
+		}}
+	}
+
+	switch kind := classifyAPIError(resp, err); {
+	case (kind == apiErrorUnauthorized || kind == apiErrorForbidden) && strings.Contains(err.Error(), "IP address"):
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "API key is restricted to other IP addresses",
+			Detail:        fmt.Sprintf("The Vultr API rejected the API key from this IP address: %v\n\nAdd this address to the key's access control list in the Vultr customer portal under Account > API.", err),
+			AttributePath: apiKey,
+		}}
+	case kind == apiErrorUnauthorized:
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid API key",
+			Detail:        fmt.Sprintf("The Vultr API did not accept the API key: %v\n\nCheck that %s holds a current key with API access enabled.\n\n%s", err, attribute, skipCredentialsHint),
+			AttributePath: apiKey,
+		}}
+	case kind == apiErrorForbidden:
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "API key is missing a required ACL",
+			Detail:        fmt.Sprintf("The user that owns the API key is not permitted to read the account: %v\n\nGrant the user the ACLs needed for the resources being managed.", err),
+			AttributePath: apiKey,
+		}}
+	default:
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable to validate API key",
+			Detail:   fmt.Sprintf("The Vultr API returned %d while validating the API key: %v\n\n%s", status, err, skipCredentialsHint),
+		}}
+	}
 }
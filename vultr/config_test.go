@@ -0,0 +1,116 @@
+package vultr
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func accountHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"account":{"name":"mock"}}`))
+}
+
+func getAccount(t *testing.T, config Config) error {
+	t.Helper()
+
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("error building client: %v", err)
+	}
+
+	_, _, err = client.govultrClient().Account.Get(context.Background())
+	return err
+}
+
+func TestConfigHTTPProxy(t *testing.T) {
+	wantAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:secret"))
+
+	proxied := false
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Host != "api.vultr.invalid" {
+			t.Errorf("expected a proxied request for api.vultr.invalid, got %s", r.URL)
+		}
+		if got := r.Header.Get("Proxy-Authorization"); got != wantAuth {
+			t.Errorf("expected proxy credentials, got %q", got)
+		}
+		proxied = true
+		accountHandler(w, r)
+	}))
+	defer proxy.Close()
+
+	err := getAccount(t, Config{
+		APIKey:      "test-key",
+		APIEndpoint: "http://api.vultr.invalid",
+		HTTPProxy:   "http://user:secret@" + proxy.Listener.Addr().String(),
+		RetryPolicy: &BackoffRetryPolicy{},
+	})
+	if err != nil || !proxied {
+		t.Fatalf("expected the request to go through the proxy, got %v", err)
+	}
+}
+
+func TestConfigTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(accountHandler))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	base := Config{APIKey: "test-key", APIEndpoint: server.URL, RetryPolicy: &BackoffRetryPolicy{}}
+
+	if err := getAccount(t, base); err == nil {
+		t.Fatal("expected an untrusted certificate to be rejected")
+	}
+
+	withCA := base
+	withCA.CABundleFile = caFile
+	if err := getAccount(t, withCA); err != nil {
+		t.Fatalf("expected the CA bundle to be trusted, got %v", err)
+	}
+
+	insecure := base
+	insecure.InsecureSkipVerify = true
+	if err := getAccount(t, insecure); err != nil {
+		t.Fatalf("expected insecure_skip_verify to accept the certificate, got %v", err)
+	}
+
+	notPEM := filepath.Join(t.TempDir(), "ca.txt")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	invalid := base
+	invalid.CABundleFile = notPEM
+	if _, err := invalid.Client(); err == nil {
+		t.Fatal("expected an error for a CA bundle without certificates")
+	}
+}
+
+func TestConfigRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(3 * time.Second):
+		case <-r.Context().Done():
+		}
+		accountHandler(w, r)
+	}))
+	defer server.Close()
+
+	start := time.Now()
+	err := getAccount(t, Config{APIKey: "test-key", APIEndpoint: server.URL, RequestTimeout: 1, RetryPolicy: &BackoffRetryPolicy{}})
+	if err == nil {
+		t.Fatal("expected the slow request to time out")
+	}
+	if time.Since(start) > 2*time.Second {
+		t.Fatalf("expected the request to give up after about a second, took %s", time.Since(start))
+	}
+}
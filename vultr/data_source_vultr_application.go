@@ -13,7 +13,9 @@ func dataSourceVultrApplication() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrApplicationRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"deploy_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -52,35 +54,37 @@ func dataSourceVultrApplicationRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	appList := []govultr.Application{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	apps, err := listAll(ctx, nil, func(v govultr.Application) string { return strconv.Itoa(v.ID) },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.Application, *govultr.Meta, error) {
+			apps, meta, _, err := client.Application.List(ctx, opts)
+			return apps, meta, err
+		})
+	if err != nil {
+		return diag.Errorf("error getting applications: %v", err)
+	}
+
+	for _, a := range apps {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(a)
 
-	for {
-		apps, meta, _, err := client.Application.List(ctx, options)
 		if err != nil {
-			return diag.Errorf("error getting applications: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, a := range apps {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(a)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				appList = append(appList, a)
-			}
+		if filterLoop(f, sm) {
+			appList = append(appList, a)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	appList, err = resolveDataSourceMatches(d, appList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
 	if len(appList) > 1 {
 		return diag.Errorf(
 			"your search returned too many results : %d. Please refine your search to be more specific",
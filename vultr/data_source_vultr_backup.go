@@ -12,7 +12,9 @@ func dataSourceVultrBackup() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrBackupRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"backups": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -32,33 +34,38 @@ func dataSourceVultrBackupRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	var backupList []map[string]interface{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	backups, err := listAll(ctx, nil, func(v govultr.Backup) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.Backup, *govultr.Meta, error) {
+			backups, meta, _, err := client.Backup.List(ctx, opts)
+			return backups, meta, err
+		})
+	if err != nil {
+		return diag.Errorf("error getting backups: %v", err)
+	}
 
-	for {
-		backups, meta, _, err := client.Backup.List(ctx, options)
+	for _, b := range backups {
+		// We need convert the struct into a map. This allows us to easily manipulate the data here.
+		sm, err := structToMap(b)
 		if err != nil {
-			return diag.Errorf("error getting backups: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, b := range backups {
-			// We need convert the struct into a map. This allows us to easily manipulate the data here.
-			sm, err := structToMap(b)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				backupList = append(backupList, sm)
-			}
+		if filterLoop(f, sm) {
+			backupList = append(backupList, sm)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	backupList, err = sortDataSourceMatches(d, backupList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("most_recent").(bool) && len(backupList) > 1 {
+		backupList = backupList[:1]
 	}
 
 	if len(backupList) < 1 {
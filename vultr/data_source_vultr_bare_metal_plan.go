@@ -12,7 +12,9 @@ func dataSourceVultrBareMetalPlan() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrBareMetalPlanRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"cpu_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -70,35 +72,37 @@ func dataSourceVultrBareMetalPlanRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	var planList []govultr.BareMetalPlan
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
-	for {
-		plans, meta, _, err := client.Plan.ListBareMetal(ctx, options)
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	plans, err := listAll(ctx, nil, func(v govultr.BareMetalPlan) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.BareMetalPlan, *govultr.Meta, error) {
+			plans, meta, _, err := client.Plan.ListBareMetal(ctx, opts)
+			return plans, meta, err
+		})
+	if err != nil {
+		return diag.Errorf("Error getting bare metal plans: %v", err)
+	}
+
+	for _, a := range plans {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(a)
 
 		if err != nil {
-			return diag.Errorf("Error getting bare metal plans: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, a := range plans {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(a)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				planList = append(planList, a)
-			}
+		if filterLoop(f, sm) {
+			planList = append(planList, a)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	planList, err = resolveDataSourceMatches(d, planList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
 	if len(planList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
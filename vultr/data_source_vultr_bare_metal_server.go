@@ -12,7 +12,9 @@ func dataSourceVultrBareMetalServer() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrBareMetalServerRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"os": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -122,35 +124,37 @@ func dataSourceVultrBareMetalServerRead(ctx context.Context, d *schema.ResourceD
 	}
 
 	serverList := []govultr.BareMetalServer{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	servers, err := listAll(ctx, nil, func(v govultr.BareMetalServer) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.BareMetalServer, *govultr.Meta, error) {
+			servers, meta, _, err := client.BareMetalServer.List(ctx, opts)
+			return servers, meta, err
+		})
+	if err != nil {
+		return diag.Errorf("error getting bare metal servers: %v", err)
+	}
+
+	for _, s := range servers {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(s)
 
-	for {
-		servers, meta, _, err := client.BareMetalServer.List(ctx, options)
 		if err != nil {
-			return diag.Errorf("error getting bare metal servers: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, s := range servers {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(s)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				serverList = append(serverList, s)
-			}
+		if filterLoop(f, sm) {
+			serverList = append(serverList, s)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	serverList, err = resolveDataSourceMatches(d, serverList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
 	if len(serverList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -227,7 +231,7 @@ func dataSourceVultrBareMetalServerRead(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("unable to set bare_metal_server `user_scheme` read value: %v", err)
 	}
 
-	vpc2s, err := getBareMetalServerVPC2s(client, d.Id())
+	vpc2s, err := getBareMetalServerVPC2s(ctx, client, d.Id())
 	if err != nil {
 		return diag.Errorf("%s", err.Error())
 	}
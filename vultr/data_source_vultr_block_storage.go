@@ -12,7 +12,9 @@ func dataSourceVultrBlockStorage() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrBlockStorageRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -63,33 +65,32 @@ func dataSourceVultrBlockStorageRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	var blockList []govultr.BlockStorage
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
-	for {
-		block, meta, _, err := client.BlockStorage.List(ctx, options)
-		if err != nil {
-			return diag.Errorf("error getting block storages: %v", err)
-		}
-
-		for _, b := range block {
-			sm, err := structToMap(b)
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	block, err := listBlockStorages(ctx, client)
+	if err != nil {
+		return diag.Errorf("error getting block storages: %v", err)
+	}
 
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	for _, b := range block {
+		sm, err := structToMap(b)
 
-			if filterLoop(f, sm) {
-				blockList = append(blockList, b)
-			}
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+		if filterLoop(f, sm) {
+			blockList = append(blockList, b)
 		}
 	}
+
+	blockList, err = resolveDataSourceMatches(d, blockList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(blockList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
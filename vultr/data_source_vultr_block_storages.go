@@ -0,0 +1,28 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrBlockStorages() *schema.Resource {
+	return blockStoragesListDataSource().resource()
+}
+
+func blockStoragesListDataSource() listDataSource[govultr.BlockStorage] {
+	return listDataSource[govultr.BlockStorage]{
+		singular:  dataSourceVultrBlockStorage(),
+		attribute: "block_storages",
+		list:      listBlockStorages,
+	}
+}
+
+func listBlockStorages(ctx context.Context, client *govultr.Client) ([]govultr.BlockStorage, error) {
+	return listAll(ctx, nil, func(v govultr.BlockStorage) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.BlockStorage, *govultr.Meta, error) {
+			block, meta, _, err := client.BlockStorage.List(ctx, opts)
+			return block, meta, err
+		})
+}
@@ -1,34 +1,94 @@
 package vultr
 
 import (
+	"cmp"
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// match_by values of a data source filter
+const (
+	filterMatchExact     = "exact"
+	filterMatchRegex     = "regex"
+	filterMatchSubstring = "substring"
+	filterMatchLT        = "lt"
+	filterMatchLTE       = "lte"
+	filterMatchGT        = "gt"
+	filterMatchGTE       = "gte"
+	filterMatchNot       = "not"
+)
+
+var filterMatchTypes = []string{
+	filterMatchExact,
+	filterMatchRegex,
+	filterMatchSubstring,
+	filterMatchLT,
+	filterMatchLTE,
+	filterMatchGT,
+	filterMatchGTE,
+	filterMatchNot,
+}
+
 type filter struct {
-	name   string
-	values []string
+	name    string
+	values  []string
+	matchBy string
+	all     bool
+
+	// the values of regex and numeric filters, parsed once when the filter
+	// is built
+	regexps []*regexp.Regexp
+	numbers []float64
 }
 
-func buildVultrDataSourceFilter(set *schema.Set) []filter {
+func buildVultrDataSourceFilter(set *schema.Set) ([]filter, error) {
 	var filters []filter
 
 	for _, v := range set.List() {
 		m := v.(map[string]interface{})
-		var values []string
+		f := filter{
+			name:    m["name"].(string),
+			matchBy: filterMatchExact,
+		}
 		for _, value := range m["values"].([]interface{}) {
-			values = append(values, value.(string))
+			f.values = append(f.values, value.(string))
 		}
-		filters = append(filters, filter{
-			name:   m["name"].(string),
-			values: values,
-		})
+		if matchBy, ok := m["match_by"].(string); ok && matchBy != "" {
+			f.matchBy = matchBy
+		}
+		if all, ok := m["all"].(bool); ok {
+			f.all = all
+		}
+
+		for _, value := range f.values {
+			switch f.matchBy {
+			case filterMatchRegex:
+				re, err := regexp.Compile(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid regex %q in filter on %s: %v", value, f.name, err)
+				}
+				f.regexps = append(f.regexps, re)
+			case filterMatchLT, filterMatchLTE, filterMatchGT, filterMatchGTE:
+				n, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, fmt.Errorf("filter on %s compares with %s, so %q must be a number", f.name, f.matchBy, value)
+				}
+				f.numbers = append(f.numbers, n)
+			}
+		}
+
+		filters = append(filters, f)
 	}
 
-	return filters
+	return filters, nil
 }
 
 func structToMap(data interface{}) (map[string]interface{}, error) {
@@ -64,40 +124,236 @@ func structToMap(data interface{}) (map[string]interface{}, error) {
 
 func filterLoop(f []filter, m map[string]interface{}) bool {
 	for _, filter := range f {
-		if !valuesLoop(filter.values, m[filter.name]) {
+		if !filter.matches(m[filter.name]) {
 			return false
 		}
 	}
 	return true
 }
 
-func valuesLoop(values []string, actual interface{}) bool {
-	switch a := actual.(type) {
-	case []interface{}:
-		// It's an array of strings, so something like: location
-		var found bool
-		for _, i := range values {
-			found = false
-			for _, j := range a {
-				if i == j {
-					found = true
-					break
+// matches reports whether an attribute, as returned by structToMap, passes the
+// filter. A scalar passes when any value matches it, or every value when all
+// is set. A list passes when every value matches one of its elements, which is
+// how filters on attributes such as locations have always worked. not is the
+// exception, passing only when no value is equal to the attribute or one of
+// its elements.
+func (f filter) matches(actual interface{}) bool {
+	if list, ok := actual.([]interface{}); ok {
+		for i := range f.values {
+			found := slices.ContainsFunc(list, func(e interface{}) bool {
+				s, ok := filterString(e)
+				if f.matchBy == filterMatchNot {
+					return ok && s == f.values[i]
 				}
+				return ok && f.matchValue(s, i)
+			})
+			if found == (f.matchBy == filterMatchNot) {
+				return false
 			}
-			if !found {
+		}
+		return true
+	}
+
+	s, ok := filterString(actual)
+	if !ok {
+		return false
+	}
+
+	if f.all || f.matchBy == filterMatchNot {
+		for i := range f.values {
+			if !f.matchValue(s, i) {
 				return false
 			}
 		}
 		return true
+	}
+
+	for i := range f.values {
+		if f.matchValue(s, i) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchValue reports whether actual matches the value at index i
+func (f filter) matchValue(actual string, i int) bool {
+	switch f.matchBy {
+	case filterMatchRegex:
+		return f.regexps[i].MatchString(actual)
+	case filterMatchSubstring:
+		return strings.Contains(actual, f.values[i])
+	case filterMatchLT, filterMatchLTE, filterMatchGT, filterMatchGTE:
+		n, err := strconv.ParseFloat(actual, 64)
+		if err != nil {
+			return false
+		}
+		switch f.matchBy {
+		case filterMatchLT:
+			return n < f.numbers[i]
+		case filterMatchLTE:
+			return n <= f.numbers[i]
+		case filterMatchGT:
+			return n > f.numbers[i]
+		default:
+			return n >= f.numbers[i]
+		}
+	case filterMatchNot:
+		return actual != f.values[i]
+	default:
+		return actual == f.values[i]
+	}
+}
+
+// filterString returns the string form of a scalar attribute or list element
+func filterString(v interface{}) (string, bool) {
+	switch val := v.(type) {
+	case string:
+		return val, true
+	case bool:
+		return strconv.FormatBool(val), true
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), true
 	default:
-		// It's a string, so something like: ram, type, vcpu_count
-		for _, i := range values {
-			if actual == i {
-				return true
+		return "", false
+	}
+}
+
+const (
+	sortAscending  = "asc"
+	sortDescending = "desc"
+
+	// mostRecentKey is the attribute that most_recent orders by
+	mostRecentKey = "date_created"
+)
+
+// timeFormats are the layouts of the dates returned by the API
+var timeFormats = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"}
+
+type sortKey struct {
+	key        string
+	descending bool
+}
+
+func buildVultrDataSourceSort(d *schema.ResourceData) []sortKey {
+	var keys []sortKey
+
+	if mostRecent, ok := d.Get("most_recent").(bool); ok && mostRecent {
+		keys = append(keys, sortKey{key: mostRecentKey, descending: true})
+	}
+
+	sorts, _ := d.Get("sort").([]interface{})
+	for _, v := range sorts {
+		m := v.(map[string]interface{})
+		keys = append(keys, sortKey{
+			key:        m["key"].(string),
+			descending: m["direction"].(string) == sortDescending,
+		})
+	}
+
+	return keys
+}
+
+// sortDataSourceMatches orders the matches of a data source by the attributes
+// structToMap produces for them, newest first when most_recent is set and then
+// by each sort block in turn. Matches without an attribute come last.
+func sortDataSourceMatches[T any](d *schema.ResourceData, matches []T) ([]T, error) {
+	keys := buildVultrDataSourceSort(d)
+	if len(keys) == 0 || len(matches) == 0 {
+		return matches, nil
+	}
+
+	maps := make([]map[string]interface{}, len(matches))
+	for i := range matches {
+		m, err := structToMap(matches[i])
+		if err != nil {
+			return nil, err
+		}
+		maps[i] = m
+	}
+
+	for _, k := range keys {
+		if !slices.ContainsFunc(maps, func(m map[string]interface{}) bool { _, ok := m[k.key]; return ok }) {
+			return nil, fmt.Errorf("unable to sort by %s: no result has that attribute", k.key)
+		}
+	}
+
+	order := make([]int, len(matches))
+	for i := range order {
+		order[i] = i
+	}
+
+	slices.SortStableFunc(order, func(a, b int) int {
+		for _, k := range keys {
+			x, xOk := filterString(maps[a][k.key])
+			y, yOk := filterString(maps[b][k.key])
+			switch {
+			case !xOk && !yOk:
+				continue
+			case !xOk:
+				return 1
+			case !yOk:
+				return -1
+			}
+
+			c := compareSortValues(x, y)
+			if k.descending {
+				c = -c
+			}
+			if c != 0 {
+				return c
 			}
 		}
-		return false
+		return 0
+	})
+
+	sorted := make([]T, len(matches))
+	for i, j := range order {
+		sorted[i] = matches[j]
+	}
+	return sorted, nil
+}
+
+// resolveDataSourceMatches lets a singular data source whose search matched
+// several items pick one. When sort blocks or most_recent are set, only the
+// first match in that order is kept.
+func resolveDataSourceMatches[T any](d *schema.ResourceData, matches []T) ([]T, error) {
+	if len(buildVultrDataSourceSort(d)) == 0 {
+		return matches, nil
+	}
+
+	sorted, err := sortDataSourceMatches(d, matches)
+	if err != nil || len(sorted) == 0 {
+		return sorted, err
+	}
+	return sorted[:1], nil
+}
+
+// compareSortValues compares two attributes as numbers or dates when both
+// parse as one, and as strings otherwise
+func compareSortValues(x, y string) int {
+	if a, err := strconv.ParseFloat(x, 64); err == nil {
+		if b, err := strconv.ParseFloat(y, 64); err == nil {
+			return cmp.Compare(a, b)
+		}
 	}
+
+	if a, ok := parseSortTime(x); ok {
+		if b, ok := parseSortTime(y); ok {
+			return a.Compare(b)
+		}
+	}
+
+	return strings.Compare(x, y)
+}
+
+func parseSortTime(v string) (time.Time, bool) {
+	for _, layout := range timeFormats {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
 }
 
 func dataSourceFiltersSchema() *schema.Schema {
@@ -117,7 +373,50 @@ func dataSourceFiltersSchema() *schema.Schema {
 					Required: true,
 					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
+
+				"match_by": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      filterMatchExact,
+					ValidateFunc: validation.StringInSlice(filterMatchTypes, false),
+				},
+
+				"all": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 			},
 		},
 	}
 }
+
+func dataSourceSortSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"direction": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      sortAscending,
+					ValidateFunc: validation.StringInSlice([]string{sortAscending, sortDescending}, false),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMostRecentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
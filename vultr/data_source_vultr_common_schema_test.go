@@ -0,0 +1,196 @@
+package vultr
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testFilters(t *testing.T, raw ...map[string]interface{}) ([]filter, error) {
+	t.Helper()
+
+	set := schema.NewSet(schema.HashResource(dataSourceFiltersSchema().Elem.(*schema.Resource)), nil)
+	for _, m := range raw {
+		if _, ok := m["match_by"]; !ok {
+			m["match_by"] = filterMatchExact
+		}
+		if _, ok := m["all"]; !ok {
+			m["all"] = false
+		}
+		set.Add(m)
+	}
+
+	return buildVultrDataSourceFilter(set)
+}
+
+func TestFilterMatches(t *testing.T) {
+	plan, err := structToMap(struct {
+		ID        string   `json:"id"`
+		VCPUCount int      `json:"vcpu_count"`
+		RAM       float64  `json:"ram"`
+		Locations []string `json:"locations"`
+		Backups   bool     `json:"backups"`
+	}{"vc2-4c-8gb", 4, 8192, []string{"ewr", "lax"}, true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		name    string
+		matchBy string
+		all     bool
+		values  []interface{}
+		want    bool
+	}{
+		"exact":                {"id", filterMatchExact, false, []interface{}{"vc2-1c-1gb", "vc2-4c-8gb"}, true},
+		"exact miss":           {"id", filterMatchExact, false, []interface{}{"vc2-1c-1gb"}, false},
+		"exact bool":           {"backups", filterMatchExact, false, []interface{}{"true"}, true},
+		"exact all":            {"id", filterMatchExact, true, []interface{}{"vc2-1c-1gb", "vc2-4c-8gb"}, false},
+		"regex":                {"id", filterMatchRegex, false, []interface{}{`^vc2-\dc-`}, true},
+		"regex all":            {"id", filterMatchRegex, true, []interface{}{`^vc2-`, `8gb$`}, true},
+		"regex all miss":       {"id", filterMatchRegex, true, []interface{}{`^vc2-`, `1gb$`}, false},
+		"substring":            {"id", filterMatchSubstring, false, []interface{}{"4c"}, true},
+		"lt":                   {"vcpu_count", filterMatchLT, false, []interface{}{"4"}, false},
+		"lte":                  {"vcpu_count", filterMatchLTE, false, []interface{}{"4"}, true},
+		"gt":                   {"ram", filterMatchGT, false, []interface{}{"4096"}, true},
+		"gte":                  {"ram", filterMatchGTE, false, []interface{}{"16384"}, false},
+		"range":                {"vcpu_count", filterMatchGT, true, []interface{}{"2", "3"}, true},
+		"not":                  {"id", filterMatchNot, false, []interface{}{"vc2-1c-1gb"}, true},
+		"not any":              {"id", filterMatchNot, false, []interface{}{"vc2-1c-1gb", "vc2-4c-8gb"}, false},
+		"list exact":           {"locations", filterMatchExact, false, []interface{}{"ewr", "lax"}, true},
+		"list exact miss":      {"locations", filterMatchExact, false, []interface{}{"ewr", "ams"}, false},
+		"list regex":           {"locations", filterMatchRegex, false, []interface{}{"^la"}, true},
+		"list not":             {"locations", filterMatchNot, false, []interface{}{"ams"}, true},
+		"list not miss":        {"locations", filterMatchNot, false, []interface{}{"ams", "ewr"}, false},
+		"missing attribute":    {"nope", filterMatchExact, false, []interface{}{""}, false},
+		"non-numeric compared": {"id", filterMatchGT, false, []interface{}{"1"}, false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f, err := testFilters(t, map[string]interface{}{
+				"name":     tc.name,
+				"values":   tc.values,
+				"match_by": tc.matchBy,
+				"all":      tc.all,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := filterLoop(f, plan); got != tc.want {
+				t.Fatalf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFilterLoopCombinesFilters(t *testing.T) {
+	m := map[string]interface{}{"region": "ewr", "vcpu_count": "4"}
+
+	f, err := testFilters(t,
+		map[string]interface{}{"name": "region", "values": []interface{}{"ewr"}},
+		map[string]interface{}{"name": "vcpu_count", "values": []interface{}{"8"}, "match_by": filterMatchGTE},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if filterLoop(f, m) {
+		t.Fatal("expected every filter to have to match")
+	}
+}
+
+func TestBuildFilterErrors(t *testing.T) {
+	if _, err := testFilters(t, map[string]interface{}{
+		"name": "id", "values": []interface{}{"("}, "match_by": filterMatchRegex,
+	}); err == nil {
+		t.Fatal("expected an invalid regex to be rejected")
+	}
+
+	if _, err := testFilters(t, map[string]interface{}{
+		"name": "ram", "values": []interface{}{"lots"}, "match_by": filterMatchGT,
+	}); err == nil {
+		t.Fatal("expected a non-numeric comparison value to be rejected")
+	}
+}
+
+type sortItem struct {
+	ID          string `json:"id"`
+	Size        int    `json:"size"`
+	DateCreated string `json:"date_created"`
+}
+
+func testSortData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	return schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"sort":        dataSourceSortSchema(),
+		"most_recent": dataSourceMostRecentSchema(),
+	}, raw)
+}
+
+func sortItemIDs(items []sortItem) []string {
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func TestSortDataSourceMatches(t *testing.T) {
+	items := []sortItem{
+		{"a", 10, "2024-03-01T00:00:00+00:00"},
+		{"b", 9, "2024-11-01T00:00:00+00:00"},
+		{"c", 100, "2023-12-31T23:00:00-05:00"},
+		{"d", 10, ""},
+	}
+
+	cases := map[string]struct {
+		raw  map[string]interface{}
+		want []string
+	}{
+		"unsorted":         {map[string]interface{}{}, []string{"a", "b", "c", "d"}},
+		"numbers":          {map[string]interface{}{"sort": []interface{}{map[string]interface{}{"key": "size"}}}, []string{"b", "a", "d", "c"}},
+		"numbers desc":     {map[string]interface{}{"sort": []interface{}{map[string]interface{}{"key": "size", "direction": "desc"}}}, []string{"c", "a", "d", "b"}},
+		"dates":            {map[string]interface{}{"sort": []interface{}{map[string]interface{}{"key": "date_created"}}}, []string{"d", "c", "a", "b"}},
+		"most recent":      {map[string]interface{}{"most_recent": true}, []string{"b", "a", "c", "d"}},
+		"ties broken":      {map[string]interface{}{"sort": []interface{}{map[string]interface{}{"key": "size"}, map[string]interface{}{"key": "id", "direction": "desc"}}}, []string{"b", "d", "a", "c"}},
+		"most recent size": {map[string]interface{}{"most_recent": true, "sort": []interface{}{map[string]interface{}{"key": "size"}}}, []string{"b", "a", "c", "d"}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sorted, err := sortDataSourceMatches(testSortData(t, tc.raw), items)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := sortItemIDs(sorted); !slices.Equal(got, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSortDataSourceMatchesUnknownKey(t *testing.T) {
+	d := testSortData(t, map[string]interface{}{"sort": []interface{}{map[string]interface{}{"key": "nope"}}})
+	if _, err := sortDataSourceMatches(d, []sortItem{{ID: "a"}, {ID: "b"}}); err == nil {
+		t.Fatal("expected sorting by an attribute no result has to fail")
+	}
+}
+
+func TestResolveDataSourceMatches(t *testing.T) {
+	items := []sortItem{
+		{"a", 1, "2024-03-01T00:00:00+00:00"},
+		{"b", 2, "2024-11-01T00:00:00+00:00"},
+	}
+
+	got, err := resolveDataSourceMatches(testSortData(t, map[string]interface{}{}), items)
+	if err != nil || len(got) != 2 {
+		t.Fatalf("expected several matches to be kept without sorting, got %v %v", got, err)
+	}
+
+	got, err = resolveDataSourceMatches(testSortData(t, map[string]interface{}{"most_recent": true}), items)
+	if err != nil || !slices.Equal(sortItemIDs(got), []string{"b"}) {
+		t.Fatalf("expected the most recent match, got %v %v", got, err)
+	}
+}
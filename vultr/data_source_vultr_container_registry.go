@@ -12,7 +12,9 @@ func dataSourceVultrContainerRegistry() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrContainerRegistryRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,33 +60,36 @@ func dataSourceVultrContainerRegistryRead(ctx context.Context, d *schema.Resourc
 	}
 
 	crList := []govultr.ContainerRegistry{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	options := &govultr.ListOptions{PerPage: 10}
 
-	for {
-		crs, meta, _, err := client.ContainerRegistry.List(ctx, options)
+	crs, err := listAll(ctx, options, func(v govultr.ContainerRegistry) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.ContainerRegistry, *govultr.Meta, error) {
+			crs, meta, _, err := client.ContainerRegistry.List(ctx, opts)
+			return crs, meta, err
+		})
+	if err != nil {
+		return diag.Errorf("error getting container registries: %v", err)
+	}
+
+	for _, u := range crs {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(u)
 		if err != nil {
-			return diag.Errorf("error getting container registries: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, u := range crs {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(u)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				crList = append(crList, u)
-			}
+		if filterLoop(f, sm) {
+			crList = append(crList, u)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	crList, err = resolveDataSourceMatches(d, crList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if len(crList) > 1 {
@@ -117,7 +122,11 @@ func dataSourceVultrContainerRegistryRead(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("unable to set container registry `date_created` read value: %v", err)
 	}
 
-	repos, _, _, err := client.ContainerRegistry.ListRepositories(ctx, crList[0].ID, nil)
+	repos, err := listAll(ctx, nil, func(v govultr.ContainerRegistryRepo) string { return v.Name },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.ContainerRegistryRepo, *govultr.Meta, error) {
+			repos, meta, _, err := client.ContainerRegistry.ListRepositories(ctx, crList[0].ID, opts)
+			return repos, meta, err
+		})
 	if err != nil {
 		return diag.Errorf("unable to retrieve container registry repositories: %v", err)
 	}
@@ -13,7 +13,9 @@ func dataSourceVultrDatabase() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrDatabaseRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -174,9 +176,11 @@ func dataSourceVultrDatabaseRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	var databaseList []govultr.Database
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.DBListOptions{}
-	databases, _, _, err := client.Database.List(ctx, options)
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	databases, err := listDatabases(ctx, client)
 	if err != nil {
 		return diag.Errorf("error getting databases: %v", err)
 	}
@@ -194,6 +198,11 @@ func dataSourceVultrDatabaseRead(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	databaseList, err = resolveDataSourceMatches(d, databaseList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(databaseList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
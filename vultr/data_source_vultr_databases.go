@@ -0,0 +1,43 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+// databaseCredentialAttributes hold the credentials of a database or one of
+// its read replicas, which are left out of vultr_databases so that matching
+// every database doesn't write every admin password to state
+var databaseCredentialAttributes = []string{"password", "access_key", "access_cert", "ferretdb_credentials"}
+
+func dataSourceVultrDatabases() *schema.Resource {
+	return databasesListDataSource().resource()
+}
+
+func databasesListDataSource() listDataSource[govultr.Database] {
+	return listDataSource[govultr.Database]{
+		singular:  dataSourceVultrDatabase(),
+		attribute: "databases",
+		omit:      databaseCredentialAttributes,
+		list:      listDatabases,
+		flatten:   flattenDatabasesDataSource,
+	}
+}
+
+func listDatabases(ctx context.Context, client *govultr.Client) ([]govultr.Database, error) {
+	databases, _, _, err := client.Database.List(ctx, &govultr.DBListOptions{})
+	return databases, err
+}
+
+func flattenDatabasesDataSource(db *govultr.Database) map[string]interface{} {
+	replicas := flattenReplicas(db)
+	for _, r := range replicas {
+		for _, k := range databaseCredentialAttributes {
+			delete(r, k)
+		}
+	}
+
+	return map[string]interface{}{"read_replicas": replicas}
+}
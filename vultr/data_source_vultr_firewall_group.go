@@ -12,7 +12,9 @@ func dataSourceVultrFirewallGroup() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrFirewallGroupRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -51,33 +53,30 @@ func dataSourceVultrFirewallGroupRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	firewallGroupList := []govultr.FirewallGroup{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	firewallGroup, err := listFirewallGroups(ctx, client)
+	if err != nil {
+		return diag.Errorf("error getting firewall group: %v", err)
+	}
+
+	for _, fw := range firewallGroup {
+		sm, err := structToMap(fw)
 
-	for {
-		firewallGroup, meta, _, err := client.FirewallGroup.List(ctx, options)
 		if err != nil {
-			return diag.Errorf("error getting firewall group: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, fw := range firewallGroup {
-			sm, err := structToMap(fw)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				firewallGroupList = append(firewallGroupList, fw)
-			}
+		if filterLoop(f, sm) {
+			firewallGroupList = append(firewallGroupList, fw)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	firewallGroupList, err = resolveDataSourceMatches(d, firewallGroupList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if len(firewallGroupList) > 1 {
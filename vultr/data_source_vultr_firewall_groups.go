@@ -0,0 +1,28 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrFirewallGroups() *schema.Resource {
+	return firewallGroupsListDataSource().resource()
+}
+
+func firewallGroupsListDataSource() listDataSource[govultr.FirewallGroup] {
+	return listDataSource[govultr.FirewallGroup]{
+		singular:  dataSourceVultrFirewallGroup(),
+		attribute: "firewall_groups",
+		list:      listFirewallGroups,
+	}
+}
+
+func listFirewallGroups(ctx context.Context, client *govultr.Client) ([]govultr.FirewallGroup, error) {
+	return listAll(ctx, nil, func(v govultr.FirewallGroup) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.FirewallGroup, *govultr.Meta, error) {
+			firewallGroup, meta, _, err := client.FirewallGroup.List(ctx, opts)
+			return firewallGroup, meta, err
+		})
+}
@@ -12,7 +12,9 @@ func dataSourceVultrInference() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrInferenceRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -44,7 +46,10 @@ func dataSourceVultrInferenceRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	var inferenceList []govultr.Inference
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	inferenceSubs, _, err := client.Inference.List(ctx)
 	if err != nil {
 		return diag.Errorf("error getting inference subscriptions: %v", err)
@@ -63,6 +68,11 @@ func dataSourceVultrInferenceRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	inferenceList, err = resolveDataSourceMatches(d, inferenceList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(inferenceList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
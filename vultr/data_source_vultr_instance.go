@@ -13,7 +13,9 @@ func dataSourceVultrInstance() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrInstanceRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"os": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -164,35 +166,33 @@ func dataSourceVultrInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	var serverList []govultr.Instance
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{PerPage: 400}
-	for {
-		servers, meta, _, err := client.Instance.List(ctx, options)
-		if err != nil {
-			return diag.Errorf("error getting servers: %v", err)
-		}
-
-		for _, s := range servers {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(s)
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	servers, err := listInstances(ctx, client, &govultr.ListOptions{PerPage: 400})
+	if err != nil {
+		return diag.Errorf("error getting servers: %v", err)
+	}
 
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	for _, s := range servers {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(s)
 
-			if filterLoop(f, sm) {
-				serverList = append(serverList, s)
-			}
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+		if filterLoop(f, sm) {
+			serverList = append(serverList, s)
 		}
 	}
 
+	serverList, err = resolveDataSourceMatches(d, serverList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(serverList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -302,12 +302,12 @@ func dataSourceVultrInstanceRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("error setting `backups_schedule`: %#v", err)
 	}
 
-	vpcs, err := getVPCs(client, d.Id())
+	vpcs, err := getVPCs(ctx, client, d.Id())
 	if err != nil {
 		return diag.Errorf("%s", err.Error())
 	}
 
-	vpc2s, err := getVPC2s(client, d.Id())
+	vpc2s, err := getVPC2s(ctx, client, d.Id())
 	if err != nil {
 		return diag.Errorf("%s", err.Error())
 	}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vultr/govultr/v3"
 )
 
 func dataSourceVultrInstances() *schema.Resource {
@@ -176,83 +175,76 @@ func dataSourceVultrInstancesRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	serverList := make([]interface{}, 0)
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
-	for {
-		servers, meta, _, err := client.Instance.List(ctx, options)
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	servers, err := listInstances(ctx, client, nil)
+	if err != nil {
+		return diag.Errorf("error getting servers: %v", err)
+	}
+
+	for _, server := range servers {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(server)
+
 		if err != nil {
-			return diag.Errorf("error getting servers: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, server := range servers {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(server)
-
+		if filterLoop(f, sm) {
+			schedule, _, err := client.Instance.GetBackupSchedule(ctx, server.ID)
 			if err != nil {
-				return diag.FromErr(err)
+				return diag.Errorf("error getting backup schedule: %v", err)
 			}
 
-			if filterLoop(f, sm) {
-				schedule, _, err := client.Instance.GetBackupSchedule(ctx, server.ID)
-				if err != nil {
-					return diag.Errorf("error getting backup schedule: %v", err)
-				}
-
-				bsInfo := map[string]interface{}{
-					"type": schedule.Type,
-					"hour": strconv.Itoa(schedule.Hour),
-					"dom":  strconv.Itoa(schedule.Dom),
-					"dow":  strconv.Itoa(schedule.Dow),
-				}
-
-				vpcs, err := getVPCs(client, server.ID)
-				if err != nil {
-					return diag.Errorf("%s", err.Error())
-				}
+			bsInfo := map[string]interface{}{
+				"type": schedule.Type,
+				"hour": strconv.Itoa(schedule.Hour),
+				"dom":  strconv.Itoa(schedule.Dom),
+				"dow":  strconv.Itoa(schedule.Dow),
+			}
 
-				serverList = append(serverList, map[string]interface{}{
-					"id":                  server.ID,
-					"os":                  server.Os,
-					"ram":                 server.RAM,
-					"disk":                server.Disk,
-					"main_ip":             server.MainIP,
-					"vcpu_count":          server.VCPUCount,
-					"region":              server.Region,
-					"date_created":        server.DateCreated,
-					"allowed_bandwidth":   server.AllowedBandwidth,
-					"netmask_v4":          server.NetmaskV4,
-					"gateway_v4":          server.GatewayV4,
-					"status":              server.Status,
-					"power_status":        server.PowerStatus,
-					"server_status":       server.ServerStatus,
-					"plan":                server.Plan,
-					"label":               server.Label,
-					"internal_ip":         server.InternalIP,
-					"kvm":                 server.KVM,
-					"tags":                server.Tags,
-					"os_id":               server.OsID,
-					"app_id":              server.AppID,
-					"image_id":            server.ImageID,
-					"firewall_group_id":   server.FirewallGroupID,
-					"v6_network":          server.V6Network,
-					"v6_main_ip":          server.V6MainIP,
-					"v6_network_size":     server.V6NetworkSize,
-					"features":            server.Features,
-					"hostname":            server.Hostname,
-					"user_scheme":         server.UserScheme,
-					"backups":             backupStatus(schedule.Enabled),
-					"backups_schedule":    bsInfo,
-					"private_network_ids": vpcs,
-					"vpc_ids":             vpcs,
-				})
+			vpcs, err := getVPCs(ctx, client, server.ID)
+			if err != nil {
+				return diag.Errorf("%s", err.Error())
 			}
-		}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+			serverList = append(serverList, map[string]interface{}{
+				"id":                  server.ID,
+				"os":                  server.Os,
+				"ram":                 server.RAM,
+				"disk":                server.Disk,
+				"main_ip":             server.MainIP,
+				"vcpu_count":          server.VCPUCount,
+				"region":              server.Region,
+				"date_created":        server.DateCreated,
+				"allowed_bandwidth":   server.AllowedBandwidth,
+				"netmask_v4":          server.NetmaskV4,
+				"gateway_v4":          server.GatewayV4,
+				"status":              server.Status,
+				"power_status":        server.PowerStatus,
+				"server_status":       server.ServerStatus,
+				"plan":                server.Plan,
+				"label":               server.Label,
+				"internal_ip":         server.InternalIP,
+				"kvm":                 server.KVM,
+				"tags":                server.Tags,
+				"os_id":               server.OsID,
+				"app_id":              server.AppID,
+				"image_id":            server.ImageID,
+				"firewall_group_id":   server.FirewallGroupID,
+				"v6_network":          server.V6Network,
+				"v6_main_ip":          server.V6MainIP,
+				"v6_network_size":     server.V6NetworkSize,
+				"features":            server.Features,
+				"hostname":            server.Hostname,
+				"user_scheme":         server.UserScheme,
+				"backups":             backupStatus(schedule.Enabled),
+				"backups_schedule":    bsInfo,
+				"private_network_ids": vpcs,
+				"vpc_ids":             vpcs,
+			})
 		}
 	}
 
@@ -12,7 +12,9 @@ func dataSourceVultrIsoPrivate() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrIsoPrivateRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -50,34 +52,36 @@ func dataSourceVultrIsoPrivateRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var isoList []govultr.ISO
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	iso, err := listAll(ctx, nil, func(v govultr.ISO) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.ISO, *govultr.Meta, error) {
+			iso, meta, _, err := client.ISO.List(ctx, opts)
+			return iso, meta, err
+		})
+	if err != nil {
+		return diag.Errorf("error getting isos: %v", err)
+	}
+
+	for _, i := range iso {
+		sm, err := structToMap(i)
 
-	for {
-		iso, meta, _, err := client.ISO.List(ctx, options)
 		if err != nil {
-			return diag.Errorf("error getting isos: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, i := range iso {
-			sm, err := structToMap(i)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				isoList = append(isoList, i)
-			}
+		if filterLoop(f, sm) {
+			isoList = append(isoList, i)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	isoList, err = resolveDataSourceMatches(d, isoList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
 	if len(isoList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -12,7 +12,9 @@ func dataSourceVultrIsoPublic() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrIsoPublicRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -39,33 +41,34 @@ func dataSourceVultrIsoPublicRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	isoList := []govultr.PublicISO{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	iso, err := listAll(ctx, nil, func(v govultr.PublicISO) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.PublicISO, *govultr.Meta, error) {
+			iso, meta, _, err := client.ISO.ListPublic(ctx, opts)
+			return iso, meta, err
+		})
+	if err != nil {
+		return diag.Errorf("Error getting isos: %v", err)
+	}
+
+	for _, i := range iso {
+		sm, err := structToMap(i)
 
-	for {
-		iso, meta, _, err := client.ISO.ListPublic(ctx, options)
 		if err != nil {
-			return diag.Errorf("Error getting isos: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, i := range iso {
-			sm, err := structToMap(i)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				isoList = append(isoList, i)
-			}
+		if filterLoop(f, sm) {
+			isoList = append(isoList, i)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	isoList, err = resolveDataSourceMatches(d, isoList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if len(isoList) > 1 {
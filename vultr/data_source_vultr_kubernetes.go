@@ -12,7 +12,9 @@ func dataSourceVultrKubernetes() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrKubernetesRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -99,34 +101,32 @@ func dataSourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var k8List []govultr.Cluster
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
-	for {
-		k8s, meta, _, err := client.Kubernetes.ListClusters(ctx, options)
-		if err != nil {
-			return diag.Errorf("error getting kubernetes")
-		}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	k8s, err := listKubernetesClusters(ctx, client)
+	if err != nil {
+		return diag.Errorf("error getting kubernetes")
+	}
 
-		for _, k8 := range k8s {
-			sm, err := structToMap(k8)
+	for _, k8 := range k8s {
+		sm, err := structToMap(k8)
 
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				k8List = append(k8List, k8)
-			}
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+		if filterLoop(f, sm) {
+			k8List = append(k8List, k8)
 		}
 	}
 
+	k8List, err = resolveDataSourceMatches(d, k8List)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(k8List) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
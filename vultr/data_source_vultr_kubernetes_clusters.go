@@ -0,0 +1,32 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrKubernetesClusters() *schema.Resource {
+	return kubernetesClustersListDataSource().resource()
+}
+
+func kubernetesClustersListDataSource() listDataSource[govultr.Cluster] {
+	return listDataSource[govultr.Cluster]{
+		singular:  dataSourceVultrKubernetes(),
+		attribute: "kubernetes_clusters",
+		omit:      []string{"kube_config", "cluster_ca_certificate", "client_certificate", "client_key"},
+		list:      listKubernetesClusters,
+		flatten: func(cluster *govultr.Cluster) map[string]interface{} {
+			return map[string]interface{}{"node_pools": flattenNodePools(cluster.NodePools)}
+		},
+	}
+}
+
+func listKubernetesClusters(ctx context.Context, client *govultr.Client) ([]govultr.Cluster, error) {
+	return listAll(ctx, nil, func(v govultr.Cluster) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.Cluster, *govultr.Meta, error) {
+			k8s, meta, _, err := client.Kubernetes.ListClusters(ctx, opts)
+			return k8s, meta, err
+		})
+}
@@ -0,0 +1,246 @@
+package vultr
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+// listDataSource is a plural data source, which returns every item that its
+// filters match instead of exactly one. Each item has the attributes of the
+// matching singular data source.
+type listDataSource[T any] struct {
+	// singular is the data source whose attributes the items have
+	singular *schema.Resource
+
+	// attribute is the list the items are returned in
+	attribute string
+
+	// omit are attributes of the singular data source, or of its nested
+	// blocks, that need more API calls per item or hold secrets that
+	// shouldn't be read in bulk
+	omit []string
+
+	list func(ctx context.Context, client *govultr.Client) ([]T, error)
+
+	// flatten returns the attributes whose names or shapes differ from the
+	// JSON of an item. The rest are flattened by flattenDataSourceItem.
+	flatten func(item *T) map[string]interface{}
+}
+
+func (l listDataSource[T]) resource() *schema.Resource {
+	elem := l.elem()
+
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return l.read(ctx, d, meta, elem)
+		},
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"sort":   dataSourceSortSchema(),
+			l.attribute: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: elem},
+			},
+		},
+	}
+}
+
+// elem returns the attributes of an item
+func (l listDataSource[T]) elem() map[string]*schema.Schema {
+	elem := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for k, s := range l.singular.Schema {
+		switch k {
+		case "filter", "sort", "most_recent":
+			continue
+		}
+		if !slices.Contains(l.omit, k) {
+			elem[k] = omitNestedAttributes(s, l.omit)
+		}
+	}
+
+	return elem
+}
+
+// omitNestedAttributes returns s without the omit attributes of its nested
+// blocks
+func omitNestedAttributes(s *schema.Schema, omit []string) *schema.Schema {
+	nested, ok := s.Elem.(*schema.Resource)
+	if !ok {
+		return s
+	}
+
+	attrs := make(map[string]*schema.Schema, len(nested.Schema))
+	for k, ns := range nested.Schema {
+		if !slices.Contains(omit, k) {
+			attrs[k] = omitNestedAttributes(ns, omit)
+		}
+	}
+
+	c := *s
+	c.Elem = &schema.Resource{Schema: attrs}
+	return &c
+}
+
+// flattenItem returns the attributes in elem of an item
+func (l listDataSource[T]) flattenItem(item *T, elem map[string]*schema.Schema) (map[string]interface{}, error) {
+	m, err := flattenDataSourceItem(*item, elem)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.flatten != nil {
+		maps.Copy(m, l.flatten(item))
+	}
+
+	return m, nil
+}
+
+func (l listDataSource[T]) read(ctx context.Context, d *schema.ResourceData, meta interface{}, elem map[string]*schema.Schema) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	var f []filter
+	if filters, ok := d.GetOk("filter"); ok {
+		var err error
+		if f, err = buildVultrDataSourceFilter(filters.(*schema.Set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	items, err := l.list(ctx, client)
+	if err != nil {
+		return diag.Errorf("error getting %s: %v", l.attribute, err)
+	}
+
+	var matches []T
+	for _, item := range items {
+		sm, err := structToMap(item)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if filterLoop(f, sm) {
+			matches = append(matches, item)
+		}
+	}
+
+	matches, err = sortDataSourceMatches(d, matches)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(matches))
+	for i := range matches {
+		m, err := l.flattenItem(&matches[i], elem)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		result = append(result, m)
+	}
+
+	d.SetId(l.attribute)
+	if err := d.Set(l.attribute, result); err != nil {
+		return diag.Errorf("unable to set `%s` read value: %v", l.attribute, err)
+	}
+
+	return nil
+}
+
+// flattenDataSourceItem converts an API object into the attributes in elem by
+// matching their names with the JSON keys of the object, converting each
+// value to the type of its attribute. Attributes without a matching key are
+// left unset.
+func flattenDataSourceItem(item interface{}, elem map[string]*schema.Schema) (map[string]interface{}, error) {
+	b, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	return flattenDataSourceObject(raw, elem), nil
+}
+
+func flattenDataSourceObject(raw map[string]interface{}, elem map[string]*schema.Schema) map[string]interface{} {
+	m := make(map[string]interface{})
+	for k, s := range elem {
+		if v, ok := flattenDataSourceValue(raw[k], s); ok {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+func flattenDataSourceValue(v interface{}, s *schema.Schema) (interface{}, bool) {
+	if v == nil {
+		return nil, false
+	}
+
+	switch s.Type {
+	case schema.TypeString:
+		return filterString(v)
+	case schema.TypeInt:
+		n, ok := v.(float64)
+		return int(n), ok
+	case schema.TypeFloat:
+		n, ok := v.(float64)
+		return n, ok
+	case schema.TypeBool:
+		b, ok := v.(bool)
+		return b, ok
+	case schema.TypeMap:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		elem, ok := s.Elem.(*schema.Schema)
+		if !ok {
+			elem = &schema.Schema{Type: schema.TypeString}
+		}
+
+		out := make(map[string]interface{}, len(obj))
+		for k, e := range obj {
+			if fv, ok := flattenDataSourceValue(e, elem); ok {
+				out[k] = fv
+			}
+		}
+		return out, true
+	case schema.TypeList, schema.TypeSet:
+		list, ok := v.([]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		out := make([]interface{}, 0, len(list))
+		for _, e := range list {
+			switch elem := s.Elem.(type) {
+			case *schema.Resource:
+				if obj, ok := e.(map[string]interface{}); ok {
+					out = append(out, flattenDataSourceObject(obj, elem.Schema))
+				}
+			case *schema.Schema:
+				if fv, ok := flattenDataSourceValue(e, elem); ok {
+					out = append(out, fv)
+				}
+			}
+		}
+		return out, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,253 @@
+package vultr
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func TestListDataSourceRead(t *testing.T) {
+	lbs := []govultr.LoadBalancer{
+		{ID: "1", Label: "web-a", Region: "ewr", SSLInfo: govultr.BoolToBoolPtr(true), Instances: []string{"i-1"}},
+		{ID: "2", Label: "web-b", Region: "ewr", HealthCheck: &govultr.HealthCheck{Protocol: "http", Port: 80}},
+		{ID: "3", Label: "web-c", Region: "lax"},
+	}
+
+	r := listDataSource[govultr.LoadBalancer]{
+		singular:  dataSourceVultrLoadBalancer(),
+		attribute: "load_balancers",
+		list: func(ctx context.Context, client *govultr.Client) ([]govultr.LoadBalancer, error) {
+			return lbs, nil
+		},
+		flatten: flattenLBDataSource,
+	}.resource()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"filter": []interface{}{map[string]interface{}{"name": "region", "values": []interface{}{"ewr"}}},
+		"sort":   []interface{}{map[string]interface{}{"key": "label", "direction": "desc"}},
+	})
+
+	if diags := r.ReadContext(context.Background(), d, &Client{}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("load_balancers.#").(int); got != 2 {
+		t.Fatalf("expected 2 load balancers, got %d", got)
+	}
+
+	for key, want := range map[string]interface{}{
+		"load_balancers.0.id":                   "2",
+		"load_balancers.0.label":                "web-b",
+		"load_balancers.0.health_check.port":    "80",
+		"load_balancers.1.id":                   "1",
+		"load_balancers.1.has_ssl":              true,
+		"load_balancers.1.attached_instances.0": "i-1",
+	} {
+		if got := d.Get(key); got != want {
+			t.Errorf("expected %s to be %v, got %v", key, want, got)
+		}
+	}
+}
+
+func TestFlattenDataSourceItem(t *testing.T) {
+	elem := dataSourceVultrPlan().Schema
+	m, err := flattenDataSourceItem(govultr.Plan{
+		ID:          "vc2-1c-1gb",
+		VCPUCount:   1,
+		MonthlyCost: 5,
+		Locations:   []string{"ewr"},
+	}, elem)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if m["vcpu_count"] != 1 {
+		t.Errorf("expected an int vcpu_count, got %#v", m["vcpu_count"])
+	}
+	if m["monthly_cost"] != float64(5) {
+		t.Errorf("expected a float monthly_cost, got %#v", m["monthly_cost"])
+	}
+	if locations, ok := m["locations"].([]interface{}); !ok || len(locations) != 1 || locations[0] != "ewr" {
+		t.Errorf("expected the locations list, got %#v", m["locations"])
+	}
+	if _, ok := m["filter"]; ok {
+		t.Error("expected attributes missing from the JSON to be left unset")
+	}
+}
+
+func TestDatabasesDataSourceOmitsCredentials(t *testing.T) {
+	p, client := testFakeProvider(t)
+	r := p.DataSourcesMap["vultr_databases"]
+
+	if _, _, err := client.Database.Create(context.Background(), &govultr.DatabaseCreateReq{
+		DatabaseEngine: "pg",
+		Region:         "ewr",
+		Plan:           "vultr-dbaas-startup-cc-1-55-2",
+		Label:          "credentials",
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	if diags := r.ReadContext(context.Background(), d, p.Meta()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("databases.#").(int); got != 1 {
+		t.Fatalf("expected 1 database, got %d", got)
+	}
+	for key, value := range d.State().Attributes {
+		if strings.Contains(value, "fake-database-password") {
+			t.Errorf("expected the database password to be left out of state, found it in %s", key)
+		}
+	}
+
+	replicas := flattenDatabasesDataSource(&govultr.Database{
+		ReadReplicas: []govultr.Database{{ID: "replica", Password: "replica-password"}},
+	})["read_replicas"].([]map[string]interface{})
+	if _, ok := replicas[0]["password"]; ok || replicas[0]["id"] != "replica" {
+		t.Errorf("expected the replica without its password, got %v", replicas[0])
+	}
+}
+
+func TestListDataSourcesReadEveryAttribute(t *testing.T) {
+	missing := map[string][]string{
+		"vultr_block_storages": testListDataSourceMissing(t, blockStoragesListDataSource()),
+		"vultr_databases": testListDataSourceMissing(t, databasesListDataSource(),
+			func(db *govultr.Database) { db.DatabaseEngine = "mysql" },
+			func(db *govultr.Database) { db.DatabaseEngine = "valkey" },
+		),
+		"vultr_firewall_groups":     testListDataSourceMissing(t, firewallGroupsListDataSource()),
+		"vultr_kubernetes_clusters": testListDataSourceMissing(t, kubernetesClustersListDataSource()),
+		"vultr_load_balancers":      testListDataSourceMissing(t, loadBalancersListDataSource()),
+		"vultr_operating_systems":   testListDataSourceMissing(t, operatingSystemsListDataSource()),
+		"vultr_plans":               testListDataSourceMissing(t, plansListDataSource()),
+		"vultr_regions":             testListDataSourceMissing(t, regionsListDataSource()),
+		"vultr_reserved_ips":        testListDataSourceMissing(t, reservedIPsListDataSource()),
+		"vultr_snapshots":           testListDataSourceMissing(t, snapshotsListDataSource()),
+		"vultr_ssh_keys":            testListDataSourceMissing(t, sshKeysListDataSource()),
+		"vultr_users":               testListDataSourceMissing(t, usersListDataSource()),
+		"vultr_vpc2s":               testListDataSourceMissing(t, vpc2sListDataSource()),
+	}
+
+	for name, r := range Provider().DataSourcesMap {
+		if _, plural := r.Schema["sort"]; plural && len(r.Schema) == 3 {
+			if _, ok := missing[name]; !ok {
+				t.Errorf("expected %s to be checked", name)
+			}
+		}
+	}
+
+	for name, attrs := range missing {
+		if len(attrs) != 0 {
+			t.Errorf("expected every attribute of %s to be read from the API, nothing sets %v", name, attrs)
+		}
+	}
+}
+
+// testListDataSourceMissing flattens an item with every field set and
+// returns the attributes that are left unset. Attributes that only some
+// items have, such as those of one database engine, count as set when any of
+// the variants of the item sets them.
+func testListDataSourceMissing[T any](t *testing.T, l listDataSource[T], variants ...func(*T)) []string {
+	t.Helper()
+
+	if len(variants) == 0 {
+		variants = []func(*T){func(*T) {}}
+	}
+
+	elem := l.elem()
+	var missing []string
+	for i, variant := range variants {
+		var item T
+		testFillValue(reflect.ValueOf(&item).Elem(), 0)
+		variant(&item)
+
+		m, err := l.flattenItem(&item, elem)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		unset := testMissingAttributes("", m, elem)
+		if i == 0 {
+			missing = unset
+		} else {
+			missing = slices.DeleteFunc(missing, func(k string) bool { return !slices.Contains(unset, k) })
+		}
+	}
+
+	slices.Sort(missing)
+	return missing
+}
+
+func testMissingAttributes(prefix string, m map[string]interface{}, elem map[string]*schema.Schema) []string {
+	var missing []string
+	for k, s := range elem {
+		v, ok := m[k]
+		if !ok || v == nil {
+			missing = append(missing, prefix+k)
+			continue
+		}
+
+		// Nested blocks are checked through their first item
+		nested, ok := s.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice || rv.Len() == 0 {
+			missing = append(missing, prefix+k)
+			continue
+		}
+		if first, ok := rv.Index(0).Interface().(map[string]interface{}); ok {
+			missing = append(missing, testMissingAttributes(prefix+k+".", first, nested.Schema)...)
+		}
+	}
+	return missing
+}
+
+// testFillValue sets every field of v, and of the structs, slices, maps and
+// pointers in it, to a value that isn't empty
+func testFillValue(v reflect.Value, depth int) {
+	if depth > 5 {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString("1")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		testFillValue(v.Elem(), depth+1)
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		testFillValue(v.Index(0), depth+1)
+	case reflect.Map:
+		key, val := reflect.New(v.Type().Key()).Elem(), reflect.New(v.Type().Elem()).Elem()
+		testFillValue(key, depth+1)
+		testFillValue(val, depth+1)
+		v.Set(reflect.MakeMap(v.Type()))
+		v.SetMapIndex(key, val)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				testFillValue(v.Field(i), depth+1)
+			}
+		}
+	case reflect.Interface:
+		v.Set(reflect.ValueOf("1"))
+	}
+}
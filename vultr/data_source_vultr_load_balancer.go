@@ -13,7 +13,9 @@ func dataSourceVultrLoadBalancer() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrLoadBalancerRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -95,33 +97,32 @@ func dataSourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("issue with filter: %v", filtersOk)
 	}
 	var lbList []govultr.LoadBalancer
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
-	for {
-		lbs, meta, _, err := client.LoadBalancer.List(ctx, options)
-		if err != nil {
-			return diag.Errorf("error getting load balancer: %v", err)
-		}
-
-		for _, b := range lbs {
-			sm, err := structToMap(b)
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	lbs, err := listLoadBalancers(ctx, client)
+	if err != nil {
+		return diag.Errorf("error getting load balancer: %v", err)
+	}
 
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	for _, b := range lbs {
+		sm, err := structToMap(b)
 
-			if filterLoop(f, sm) {
-				lbList = append(lbList, b)
-			}
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+		if filterLoop(f, sm) {
+			lbList = append(lbList, b)
 		}
 	}
+
+	lbList, err = resolveDataSourceMatches(d, lbList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(lbList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -131,24 +132,6 @@ func dataSourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.SetId(lbList[0].ID)
-	if err := d.Set("has_ssl", lbList[0].SSLInfo); err != nil {
-		return diag.Errorf("unable to set load_balancer `has_ssl` read value: %v", err)
-	}
-	if err := d.Set("attached_instances", lbList[0].Instances); err != nil {
-		return diag.Errorf("unable to set load_balancer `attached_instances` read value: %v", err)
-	}
-	if err := d.Set("balancing_algorithm", lbList[0].GenericInfo.BalancingAlgorithm); err != nil {
-		return diag.Errorf("unable to set load_balancer `balancing_algorithm` read value: %v", err)
-	}
-	if err := d.Set("ssl_redirect", lbList[0].GenericInfo.SSLRedirect); err != nil {
-		return diag.Errorf("unable to set load_balancer `ssl_redirect` read value: %v", err)
-	}
-	if err := d.Set("proxy_protocol", lbList[0].GenericInfo.ProxyProtocol); err != nil {
-		return diag.Errorf("unable to set load_balancer `proxy_protocol` read value: %v", err)
-	}
-	if err := d.Set("cookie_name", lbList[0].GenericInfo.StickySessions.CookieName); err != nil {
-		return diag.Errorf("unable to set load_balancer `cookie_name` read value: %v", err)
-	}
 	if err := d.Set("date_created", lbList[0].DateCreated); err != nil {
 		return diag.Errorf("unable to set load_balancer `date_created` read value: %v", err)
 	}
@@ -168,8 +151,20 @@ func dataSourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("unable to set load_balancer `ipv6` read value: %v", err)
 	}
 
+	for k, v := range flattenLBDataSource(&lbList[0]) {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("unable to set load_balancer `%s` read value: %v", k, err)
+		}
+	}
+
+	return nil
+}
+
+// flattenLBDataSource returns the attributes of the load balancer data sources
+// that don't match the JSON of the load balancer
+func flattenLBDataSource(lb *govultr.LoadBalancer) map[string]interface{} {
 	var rulesList []map[string]interface{}
-	for _, rules := range lbList[0].ForwardingRules {
+	for _, rules := range lb.ForwardingRules {
 		rule := map[string]interface{}{
 			"rule_id":           rules.RuleID,
 			"frontend_protocol": rules.FrontendProtocol,
@@ -180,26 +175,8 @@ func dataSourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData
 		rulesList = append(rulesList, rule)
 	}
 
-	if err := d.Set("forwarding_rules", rulesList); err != nil {
-		return diag.Errorf("unable to set load_balancer `forwarding_rules` read value: %v", err)
-	}
-
-	hcInfo := map[string]interface{}{
-		"protocol":            lbList[0].HealthCheck.Protocol,
-		"port":                strconv.Itoa(lbList[0].HealthCheck.Port),
-		"path":                lbList[0].HealthCheck.Path,
-		"check_interval":      strconv.Itoa(lbList[0].HealthCheck.CheckInterval),
-		"response_timeout":    strconv.Itoa(lbList[0].HealthCheck.ResponseTimeout),
-		"unhealthy_threshold": strconv.Itoa(lbList[0].HealthCheck.UnhealthyThreshold),
-		"healthy_threshold":   strconv.Itoa(lbList[0].HealthCheck.HealthyThreshold),
-	}
-
-	if err := d.Set("health_check", hcInfo); err != nil {
-		return diag.Errorf("unable to set load_balancer `health_check` read value: %v", err)
-	}
-
 	var fwrRules []map[string]interface{}
-	for _, rules := range lbList[0].FirewallRules {
+	for _, rules := range lb.FirewallRules {
 		rule := map[string]interface{}{
 			"id":      rules.RuleID,
 			"ip_type": rules.IPType,
@@ -209,9 +186,33 @@ func dataSourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData
 		fwrRules = append(fwrRules, rule)
 	}
 
-	if err := d.Set("firewall_rules", fwrRules); err != nil {
-		return diag.Errorf("unable to set load_balancer `firewall_rules` read value: %v", err)
+	m := map[string]interface{}{
+		"has_ssl":            lb.SSLInfo,
+		"attached_instances": lb.Instances,
+		"forwarding_rules":   rulesList,
+		"firewall_rules":     fwrRules,
 	}
 
-	return nil
+	if lb.GenericInfo != nil {
+		m["balancing_algorithm"] = lb.GenericInfo.BalancingAlgorithm
+		m["ssl_redirect"] = lb.GenericInfo.SSLRedirect
+		m["proxy_protocol"] = lb.GenericInfo.ProxyProtocol
+		if lb.GenericInfo.StickySessions != nil {
+			m["cookie_name"] = lb.GenericInfo.StickySessions.CookieName
+		}
+	}
+
+	if lb.HealthCheck != nil {
+		m["health_check"] = map[string]interface{}{
+			"protocol":            lb.HealthCheck.Protocol,
+			"port":                strconv.Itoa(lb.HealthCheck.Port),
+			"path":                lb.HealthCheck.Path,
+			"check_interval":      strconv.Itoa(lb.HealthCheck.CheckInterval),
+			"response_timeout":    strconv.Itoa(lb.HealthCheck.ResponseTimeout),
+			"unhealthy_threshold": strconv.Itoa(lb.HealthCheck.UnhealthyThreshold),
+			"healthy_threshold":   strconv.Itoa(lb.HealthCheck.HealthyThreshold),
+		}
+	}
+
+	return m
 }
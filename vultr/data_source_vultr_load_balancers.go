@@ -0,0 +1,30 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrLoadBalancers() *schema.Resource {
+	return loadBalancersListDataSource().resource()
+}
+
+func loadBalancersListDataSource() listDataSource[govultr.LoadBalancer] {
+	return listDataSource[govultr.LoadBalancer]{
+		singular:  dataSourceVultrLoadBalancer(),
+		attribute: "load_balancers",
+		omit:      []string{"ssl"},
+		list:      listLoadBalancers,
+		flatten:   flattenLBDataSource,
+	}
+}
+
+func listLoadBalancers(ctx context.Context, client *govultr.Client) ([]govultr.LoadBalancer, error) {
+	return listAll(ctx, nil, func(v govultr.LoadBalancer) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.LoadBalancer, *govultr.Meta, error) {
+			lbs, meta, _, err := client.LoadBalancer.List(ctx, opts)
+			return lbs, meta, err
+		})
+}
@@ -12,7 +12,9 @@ func dataSourceVultrObjectStorage() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrObjectStorageRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -64,35 +66,37 @@ func dataSourceVultrObjectStorageRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	objStoreList := []govultr.ObjectStorage{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	objectStorages, err := listAll(ctx, nil, func(v govultr.ObjectStorage) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.ObjectStorage, *govultr.Meta, error) {
+			objectStorages, meta, _, err := client.ObjectStorage.List(ctx, opts)
+			return objectStorages, meta, err
+		})
+	if err != nil {
+		return diag.Errorf("error getting object storage list: %v", filtersOK)
+	}
+
+	for _, n := range objectStorages {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(n)
 
-	for {
-		objectStorages, meta, _, err := client.ObjectStorage.List(ctx, options)
 		if err != nil {
-			return diag.Errorf("error getting object storage list: %v", filtersOK)
+			return diag.FromErr(err)
 		}
 
-		for _, n := range objectStorages {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(n)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				objStoreList = append(objStoreList, n)
-			}
+		if filterLoop(f, sm) {
+			objStoreList = append(objStoreList, n)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	objStoreList, err = resolveDataSourceMatches(d, objStoreList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
 	if len(objStoreList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -13,7 +13,9 @@ func dataSourceVultrObjectStorageClusters() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrObjectStorageClustersRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -43,34 +45,35 @@ func dataSourceVultrObjectStorageClustersRead(ctx context.Context, d *schema.Res
 	}
 
 	clusterList := []govultr.ObjectStorageCluster{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusters, err := listAll(ctx, nil, func(v govultr.ObjectStorageCluster) string { return strconv.Itoa(v.ID) },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.ObjectStorageCluster, *govultr.Meta, error) {
+			clusters, meta, _, err := client.ObjectStorage.ListCluster(ctx, opts)
+			return clusters, meta, err
+		})
+	if err != nil {
+		return diag.Errorf("Error getting plans: %v", err)
+	}
+
+	for _, a := range clusters {
+		// we need convert the  struct INTO a map allowing for easy manipulation of the data here
+		sm, err := structToMap(a)
 
-	for {
-		clusters, meta, _, err := client.ObjectStorage.ListCluster(ctx, options)
 		if err != nil {
-			return diag.Errorf("Error getting plans: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, a := range clusters {
-			// we need convert the  struct INTO a map allowing for easy manipulation of the data here
-			sm, err := structToMap(a)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				clusterList = append(clusterList, a)
-			}
+		if filterLoop(f, sm) {
+			clusterList = append(clusterList, a)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	clusterList, err = resolveDataSourceMatches(d, clusterList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if len(clusterList) > 1 {
@@ -0,0 +1,29 @@
+package vultr
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrOperatingSystems() *schema.Resource {
+	return operatingSystemsListDataSource().resource()
+}
+
+func operatingSystemsListDataSource() listDataSource[govultr.OS] {
+	return listDataSource[govultr.OS]{
+		singular:  dataSourceVultrOS(),
+		attribute: "operating_systems",
+		list:      listOperatingSystems,
+	}
+}
+
+func listOperatingSystems(ctx context.Context, client *govultr.Client) ([]govultr.OS, error) {
+	return listAll(ctx, nil, func(v govultr.OS) string { return strconv.Itoa(v.ID) },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.OS, *govultr.Meta, error) {
+			os, meta, _, err := client.OS.List(ctx, opts)
+			return os, meta, err
+		})
+}
@@ -13,7 +13,9 @@ func dataSourceVultrOS() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrOSRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -39,34 +41,32 @@ func dataSourceVultrOSRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	osList := []govultr.OS{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
-	for {
-		os, meta, _, err := client.OS.List(ctx, options)
-		if err != nil {
-			return diag.Errorf("error getting os list: %v", err)
-		}
-
-		for _, o := range os {
-			sm, err := structToMap(o)
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	os, err := listOperatingSystems(ctx, client)
+	if err != nil {
+		return diag.Errorf("error getting os list: %v", err)
+	}
 
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	for _, o := range os {
+		sm, err := structToMap(o)
 
-			if filterLoop(f, sm) {
-				osList = append(osList, o)
-			}
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+		if filterLoop(f, sm) {
+			osList = append(osList, o)
 		}
 	}
 
+	osList, err = resolveDataSourceMatches(d, osList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(osList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -12,7 +12,9 @@ func dataSourceVultrPlan() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrPlanRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"vcpu_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -67,34 +69,31 @@ func dataSourceVultrPlanRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	planList := []govultr.Plan{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	plans, err := listPlans(ctx, client)
+	if err != nil {
+		return diag.Errorf("Error getting plans: %v", err)
+	}
+
+	for _, a := range plans {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(a)
 
-	for {
-		plans, meta, _, err := client.Plan.List(ctx, "", options)
 		if err != nil {
-			return diag.Errorf("Error getting plans: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, a := range plans {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(a)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				planList = append(planList, a)
-			}
+		if filterLoop(f, sm) {
+			planList = append(planList, a)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	planList, err = resolveDataSourceMatches(d, planList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if len(planList) > 1 {
@@ -0,0 +1,31 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrPlans() *schema.Resource {
+	return plansListDataSource().resource()
+}
+
+func plansListDataSource() listDataSource[govultr.Plan] {
+	return listDataSource[govultr.Plan]{
+		singular:  dataSourceVultrPlan(),
+		attribute: "plans",
+		list:      listPlans,
+		flatten: func(plan *govultr.Plan) map[string]interface{} {
+			return map[string]interface{}{"gpu_vram": plan.GPUVRAM}
+		},
+	}
+}
+
+func listPlans(ctx context.Context, client *govultr.Client) ([]govultr.Plan, error) {
+	return listAll(ctx, nil, func(v govultr.Plan) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.Plan, *govultr.Meta, error) {
+			plans, meta, _, err := client.Plan.List(ctx, "", opts)
+			return plans, meta, err
+		})
+}
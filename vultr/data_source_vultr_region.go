@@ -12,7 +12,9 @@ func dataSourceVultrRegion() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrRegionRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"country": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -44,35 +46,33 @@ func dataSourceVultrRegionRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	regionList := []govultr.Region{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
-	for {
-		regions, meta, _, err := client.Region.List(ctx, options)
-		if err != nil {
-			return diag.Errorf("Error getting regions: %v", err)
-		}
-
-		for _, a := range regions {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(a)
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	regions, err := listRegions(ctx, client)
+	if err != nil {
+		return diag.Errorf("Error getting regions: %v", err)
+	}
 
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	for _, a := range regions {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(a)
 
-			if filterLoop(f, sm) {
-				regionList = append(regionList, a)
-			}
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+		if filterLoop(f, sm) {
+			regionList = append(regionList, a)
 		}
 	}
 
+	regionList, err = resolveDataSourceMatches(d, regionList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(regionList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
@@ -0,0 +1,28 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrRegions() *schema.Resource {
+	return regionsListDataSource().resource()
+}
+
+func regionsListDataSource() listDataSource[govultr.Region] {
+	return listDataSource[govultr.Region]{
+		singular:  dataSourceVultrRegion(),
+		attribute: "regions",
+		list:      listRegions,
+	}
+}
+
+func listRegions(ctx context.Context, client *govultr.Client) ([]govultr.Region, error) {
+	return listAll(ctx, nil, func(v govultr.Region) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.Region, *govultr.Meta, error) {
+			regions, meta, _, err := client.Region.List(ctx, opts)
+			return regions, meta, err
+		})
+}
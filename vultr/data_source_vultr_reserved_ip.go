@@ -12,7 +12,9 @@ func dataSourceVultrReservedIP() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrReservedIPRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -50,32 +52,29 @@ func dataSourceVultrReservedIPRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	ipList := []govultr.ReservedIP{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ips, err := listReservedIPs(ctx, client)
+	if err != nil {
+		return diag.Errorf("error getting list of reserved ips: %v", err)
+	}
 
-	for {
-		ips, meta, _, err := client.ReservedIP.List(ctx, options)
+	for _, i := range ips {
+		sm, err := structToMap(i)
 		if err != nil {
-			return diag.Errorf("error getting list of reserved ips: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, i := range ips {
-			sm, err := structToMap(i)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				ipList = append(ipList, i)
-			}
+		if filterLoop(f, sm) {
+			ipList = append(ipList, i)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	ipList, err = resolveDataSourceMatches(d, ipList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if len(ipList) > 1 {
@@ -0,0 +1,28 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrReservedIPs() *schema.Resource {
+	return reservedIPsListDataSource().resource()
+}
+
+func reservedIPsListDataSource() listDataSource[govultr.ReservedIP] {
+	return listDataSource[govultr.ReservedIP]{
+		singular:  dataSourceVultrReservedIP(),
+		attribute: "reserved_ips",
+		list:      listReservedIPs,
+	}
+}
+
+func listReservedIPs(ctx context.Context, client *govultr.Client) ([]govultr.ReservedIP, error) {
+	return listAll(ctx, nil, func(v govultr.ReservedIP) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.ReservedIP, *govultr.Meta, error) {
+			ips, meta, _, err := client.ReservedIP.List(ctx, opts)
+			return ips, meta, err
+		})
+}
@@ -68,32 +68,26 @@ func dataSourceVultrReverseIPV4Read(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*Client).govultrClient()
 
 	// If the data source is not being filtered by `instance_id`, consider all instances
-	options := &govultr.ListOptions{}
 	if len(instanceIDs) == 0 {
-		for {
-			servers, meta, _, err := client.Instance.List(ctx, options)
-			if err != nil {
-				return diag.Errorf("error getting servers: %v", err)
-			}
+		servers, err := listInstances(ctx, client, nil)
+		if err != nil {
+			return diag.Errorf("error getting servers: %v", err)
+		}
 
-			for _, server := range servers {
-				instanceIDs = append(instanceIDs, server.ID)
-			}
-			if meta.Links.Next == "" {
-				break
-			} else {
-				options.Cursor = meta.Links.Next
-				continue
-			}
+		for _, server := range servers {
+			instanceIDs = append(instanceIDs, server.ID)
 		}
 	}
 
-	filter := buildVultrDataSourceFilter(filters.(*schema.Set))
+	filter, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	var result *govultr.IPv4
 	resultInstanceID := ""
 
 	for _, instanceID := range instanceIDs {
-		ipv4s, _, _, err := client.Instance.ListIPv4(ctx, instanceID, nil)
+		ipv4s, err := listInstanceIPv4s(ctx, client, instanceID)
 		if err != nil {
 			return diag.Errorf("error getting IPv4s: %v", err)
 		}
@@ -60,30 +60,24 @@ func dataSourceVultrReverseIPV6Read(ctx context.Context, d *schema.ResourceData,
 
 	// If the data source is not being filtered by `instance_id`, consider all
 	// servers
-	options := &govultr.ListOptions{}
 	if len(instanceIDs) == 0 {
-		for {
-			servers, meta, _, err := client.Instance.List(ctx, options)
-			if err != nil {
-				return diag.Errorf("Error getting servers: %v", err)
-			}
+		servers, err := listInstances(ctx, client, nil)
+		if err != nil {
+			return diag.Errorf("Error getting servers: %v", err)
+		}
 
-			for _, server := range servers {
-				// Consider servers with at least one assigned IPv6 subnet
-				if server.V6MainIP != "" {
-					instanceIDs = append(instanceIDs, server.ID)
-				}
-			}
-			if meta.Links.Next == "" {
-				break
-			} else {
-				options.Cursor = meta.Links.Next
-				continue
+		for _, server := range servers {
+			// Consider servers with at least one assigned IPv6 subnet
+			if server.V6MainIP != "" {
+				instanceIDs = append(instanceIDs, server.ID)
 			}
 		}
 	}
 
-	filter := buildVultrDataSourceFilter(filters.(*schema.Set))
+	filter, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	var result *govultr.ReverseIP
 	resultInstanceID := ""
 
@@ -12,7 +12,9 @@ func dataSourceVultrSnapshot() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrSnapshotRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -51,34 +53,32 @@ func dataSourceVultrSnapshotRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	var snapshotList []govultr.Snapshot
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	snapshots, err := listSnapshots(ctx, client)
+	if err != nil {
+		return diag.Errorf("error getting snapshots: %v", err)
+	}
+
+	for _, ssh := range snapshots {
+		sm, err := structToMap(ssh)
 
-	for {
-		snapshots, meta, _, err := client.Snapshot.List(ctx, options)
 		if err != nil {
-			return diag.Errorf("error getting snapshots: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, ssh := range snapshots {
-			sm, err := structToMap(ssh)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				snapshotList = append(snapshotList, ssh)
-			}
-		}
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+		if filterLoop(f, sm) {
+			snapshotList = append(snapshotList, ssh)
 		}
 	}
 
+	snapshotList, err = resolveDataSourceMatches(d, snapshotList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(snapshotList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
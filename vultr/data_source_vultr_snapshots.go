@@ -0,0 +1,28 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrSnapshots() *schema.Resource {
+	return snapshotsListDataSource().resource()
+}
+
+func snapshotsListDataSource() listDataSource[govultr.Snapshot] {
+	return listDataSource[govultr.Snapshot]{
+		singular:  dataSourceVultrSnapshot(),
+		attribute: "snapshots",
+		list:      listSnapshots,
+	}
+}
+
+func listSnapshots(ctx context.Context, client *govultr.Client) ([]govultr.Snapshot, error) {
+	return listAll(ctx, nil, func(v govultr.Snapshot) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.Snapshot, *govultr.Meta, error) {
+			snapshots, meta, _, err := client.Snapshot.List(ctx, opts)
+			return snapshots, meta, err
+		})
+}
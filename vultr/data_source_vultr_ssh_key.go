@@ -12,7 +12,9 @@ func dataSourceVultrSSHKey() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrSSHKeyRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -43,34 +45,32 @@ func dataSourceVultrSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	sshKeyList := []govultr.SSHKey{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sshKeys, err := listSSHKeys(ctx, client)
+	if err != nil {
+		return diag.Errorf("error getting SSH keys: %v", err)
+	}
+
+	for _, ssh := range sshKeys {
+		sm, err := structToMap(ssh)
 
-	for {
-		sshKeys, meta, _, err := client.SSHKey.List(ctx, options)
 		if err != nil {
-			return diag.Errorf("error getting SSH keys: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, ssh := range sshKeys {
-			sm, err := structToMap(ssh)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				sshKeyList = append(sshKeyList, ssh)
-			}
+		if filterLoop(f, sm) {
+			sshKeyList = append(sshKeyList, ssh)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	sshKeyList, err = resolveDataSourceMatches(d, sshKeyList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+
 	if len(sshKeyList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
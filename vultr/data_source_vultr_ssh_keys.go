@@ -0,0 +1,28 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrSSHKeys() *schema.Resource {
+	return sshKeysListDataSource().resource()
+}
+
+func sshKeysListDataSource() listDataSource[govultr.SSHKey] {
+	return listDataSource[govultr.SSHKey]{
+		singular:  dataSourceVultrSSHKey(),
+		attribute: "ssh_keys",
+		list:      listSSHKeys,
+	}
+}
+
+func listSSHKeys(ctx context.Context, client *govultr.Client) ([]govultr.SSHKey, error) {
+	return listAll(ctx, nil, func(v govultr.SSHKey) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.SSHKey, *govultr.Meta, error) {
+			sshKeys, meta, _, err := client.SSHKey.List(ctx, opts)
+			return sshKeys, meta, err
+		})
+}
@@ -12,7 +12,9 @@ func dataSourceVultrStartupScript() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrStartupScriptRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,34 +49,36 @@ func dataSourceVultrStartupScriptRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	var scriptList []govultr.StartupScript
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	scripts, err := listAll(ctx, nil, func(v govultr.StartupScript) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.StartupScript, *govultr.Meta, error) {
+			scripts, meta, _, err := client.StartupScript.List(ctx, opts)
+			return scripts, meta, err
+		})
+	if err != nil {
+		return diag.Errorf("error getting startup scripts: %v", err)
+	}
 
-	for {
-		scripts, meta, _, err := client.StartupScript.List(ctx, options)
+	for _, script := range scripts {
+		sm, err := structToMap(script)
 
 		if err != nil {
-			return diag.Errorf("error getting startup scripts: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, script := range scripts {
-			sm, err := structToMap(script)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				scriptList = append(scriptList, script)
-			}
-		}
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
+		if filterLoop(f, sm) {
+			scriptList = append(scriptList, script)
 		}
 	}
+
+	scriptList, err = resolveDataSourceMatches(d, scriptList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if len(scriptList) > 1 {
 		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
 	}
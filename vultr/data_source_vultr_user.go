@@ -12,7 +12,9 @@ func dataSourceVultrUser() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrUserRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -43,33 +45,31 @@ func dataSourceVultrUserRead(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("issue with filter: %v", filtersOk)
 	}
 
-	options := &govultr.ListOptions{}
 	userList := []govultr.User{}
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	for {
-		users, meta, _, err := client.User.List(ctx, options)
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	users, err := listUsers(ctx, client)
+	if err != nil {
+		return diag.Errorf("error getting users: %v", err)
+	}
+
+	for _, u := range users {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(u)
 		if err != nil {
-			return diag.Errorf("error getting users: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, u := range users {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(u)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				userList = append(userList, u)
-			}
+		if filterLoop(f, sm) {
+			userList = append(userList, u)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	userList, err = resolveDataSourceMatches(d, userList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if len(userList) > 1 {
@@ -0,0 +1,31 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrUsers() *schema.Resource {
+	return usersListDataSource().resource()
+}
+
+func usersListDataSource() listDataSource[govultr.User] {
+	return listDataSource[govultr.User]{
+		singular:  dataSourceVultrUser(),
+		attribute: "users",
+		list:      listUsers,
+		flatten: func(user *govultr.User) map[string]interface{} {
+			return map[string]interface{}{"acl": user.ACL}
+		},
+	}
+}
+
+func listUsers(ctx context.Context, client *govultr.Client) ([]govultr.User, error) {
+	return listAll(ctx, nil, func(v govultr.User) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.User, *govultr.Meta, error) {
+			users, meta, _, err := client.User.List(ctx, opts)
+			return users, meta, err
+		})
+}
@@ -12,7 +12,9 @@ func dataSourceVultrVPC() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrVPCRead,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,34 +49,35 @@ func dataSourceVultrVPCRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	var vpcList []govultr.VPC
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpcs, err := listAll(ctx, nil, func(v govultr.VPC) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.VPC, *govultr.Meta, error) {
+			vpcs, meta, _, err := client.VPC.List(ctx, opts)
+			return vpcs, meta, err
+		})
+	if err != nil {
+		return diag.Errorf("error getting VPCs: %v", err)
+	}
+
+	for _, n := range vpcs {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(n)
 
-	for {
-		vpcs, meta, _, err := client.VPC.List(ctx, options)
 		if err != nil {
-			return diag.Errorf("error getting VPCs: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, n := range vpcs {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(n)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				vpcList = append(vpcList, n)
-			}
+		if filterLoop(f, sm) {
+			vpcList = append(vpcList, n)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	vpcList, err = resolveDataSourceMatches(d, vpcList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if len(vpcList) > 1 {
@@ -12,7 +12,9 @@ func dataSourceVultrVPC2() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrVPC2Read,
 		Schema: map[string]*schema.Schema{
-			"filter": dataSourceFiltersSchema(),
+			"filter":      dataSourceFiltersSchema(),
+			"sort":        dataSourceSortSchema(),
+			"most_recent": dataSourceMostRecentSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,34 +49,31 @@ func dataSourceVultrVPC2Read(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	var vpcList []govultr.VPC2
-	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	f, err := buildVultrDataSourceFilter(filters.(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpcs, err := listVPC2s(ctx, client)
+	if err != nil {
+		return diag.Errorf("error getting VPCs 2.0: %v", err)
+	}
+
+	for _, n := range vpcs {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(n)
 
-	for {
-		vpcs, meta, _, err := client.VPC2.List(ctx, options)
 		if err != nil {
-			return diag.Errorf("error getting VPCs 2.0: %v", err)
+			return diag.FromErr(err)
 		}
 
-		for _, n := range vpcs {
-			// we need convert the a struct INTO a map so we can easily manipulate the data here
-			sm, err := structToMap(n)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if filterLoop(f, sm) {
-				vpcList = append(vpcList, n)
-			}
+		if filterLoop(f, sm) {
+			vpcList = append(vpcList, n)
 		}
+	}
 
-		if meta.Links.Next == "" {
-			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
-		}
+	vpcList, err = resolveDataSourceMatches(d, vpcList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	if len(vpcList) > 1 {
@@ -0,0 +1,28 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrVPC2s() *schema.Resource {
+	return vpc2sListDataSource().resource()
+}
+
+func vpc2sListDataSource() listDataSource[govultr.VPC2] {
+	return listDataSource[govultr.VPC2]{
+		singular:  dataSourceVultrVPC2(),
+		attribute: "vpc2s",
+		list:      listVPC2s,
+	}
+}
+
+func listVPC2s(ctx context.Context, client *govultr.Client) ([]govultr.VPC2, error) {
+	return listAll(ctx, nil, func(v govultr.VPC2) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.VPC2, *govultr.Meta, error) {
+			vpcs, meta, _, err := client.VPC2.List(ctx, opts)
+			return vpcs, meta, err
+		})
+}
@@ -164,6 +164,7 @@ func readReplicaSchema(isReadReplica bool) map[string]*schema.Schema {
 			ValidateFunc: validation.NoZeroValues,
 			ForceNew:     true,
 		}
+		s["tags_all"] = tagsAllSchema()
 	}
 
 	return s
@@ -0,0 +1,128 @@
+package vultr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiErrorKind classifies a failed API call by its HTTP status rather than by
+// the wording of the error, which differs between endpoints and changes over
+// time
+type apiErrorKind int
+
+const (
+	apiErrorOther apiErrorKind = iota
+	apiErrorNotFound
+	apiErrorUnauthorized
+	apiErrorForbidden
+	apiErrorRateLimited
+	apiErrorServer
+)
+
+// apiErrorBody is the JSON body of a failed API call, which govultr returns
+// as the error message
+type apiErrorBody struct {
+	Error  string `json:"error"`
+	Status int    `json:"status"`
+}
+
+// govultrGaveUp precedes the quoted error body in the error govultr returns,
+// without the response, once it stops retrying a server error
+const govultrGaveUp = "last error: "
+
+// apiErrorStatus returns the HTTP status of a failed API call. govultr calls
+// that don't return the response, such as deletes and calls that failed with
+// a server error, only carry the status in the error body.
+func apiErrorStatus(resp *http.Response, err error) int {
+	if resp != nil {
+		return resp.StatusCode
+	}
+
+	if err == nil {
+		return 0
+	}
+
+	msg := err.Error()
+	if i := strings.Index(msg, govultrGaveUp); i != -1 {
+		if quoted, unquoteErr := strconv.Unquote(msg[i+len(govultrGaveUp):]); unquoteErr == nil {
+			msg = quoted
+		}
+	}
+
+	var body apiErrorBody
+	if json.Unmarshal([]byte(msg), &body) != nil {
+		return 0
+	}
+
+	return body.Status
+}
+
+// classifyAPIError returns the kind of a failed API call
+func classifyAPIError(resp *http.Response, err error) apiErrorKind {
+	if err == nil {
+		return apiErrorOther
+	}
+
+	switch status := apiErrorStatus(resp, err); {
+	case status == http.StatusNotFound:
+		return apiErrorNotFound
+	case status == http.StatusUnauthorized:
+		return apiErrorUnauthorized
+	case status == http.StatusForbidden:
+		return apiErrorForbidden
+	case status == http.StatusTooManyRequests:
+		return apiErrorRateLimited
+	case status >= http.StatusInternalServerError:
+		return apiErrorServer
+	default:
+		return apiErrorOther
+	}
+}
+
+// isNotFound reports whether a failed API call means the object is gone
+func isNotFound(resp *http.Response, err error) bool {
+	return classifyAPIError(resp, err) == apiErrorNotFound
+}
+
+// removeIfGone clears the ID of a resource whose read failed because it no
+// longer exists, so that Terraform plans to create it again. It reports
+// whether the resource was removed.
+func removeIfGone(ctx context.Context, d *schema.ResourceData, resp *http.Response, err error) bool {
+	if !isNotFound(resp, err) {
+		return false
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("Removing resource (%s) from state because it is gone", d.Id()))
+	d.SetId("")
+	return true
+}
+
+// apiErrorf returns an error diagnostic for a failed API call, with a detail
+// explaining authentication, permission, rate limit and server errors
+func apiErrorf(resp *http.Response, err error, format string, a ...interface{}) diag.Diagnostics {
+	var detail string
+	switch classifyAPIError(resp, err) {
+	case apiErrorUnauthorized:
+		detail = "The API key was rejected. Check that it is valid and that requests come from an IP address it allows."
+	case apiErrorForbidden:
+		detail = "The API key is not allowed to perform this operation. Check the ACLs of the user it belongs to."
+	case apiErrorRateLimited:
+		detail = "The API kept rate limiting the request after it was retried. Lower the parallelism of Terraform or raise rate_limit."
+	case apiErrorServer:
+		detail = "The Vultr API returned a server error. The operation can usually be retried later."
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf(format, a...),
+		Detail:   detail,
+	}}
+}
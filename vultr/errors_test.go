@@ -0,0 +1,62 @@
+package vultr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestClassifyAPIError(t *testing.T) {
+	cases := map[string]struct {
+		resp *http.Response
+		err  error
+		want apiErrorKind
+	}{
+		"not found":           {&http.Response{StatusCode: 404}, errors.New(`{"error":"invalid instance ID","status":404}`), apiErrorNotFound},
+		"unauthorized":        {&http.Response{StatusCode: 401}, errors.New(`{"error":"Unauthorized","status":401}`), apiErrorUnauthorized},
+		"forbidden":           {&http.Response{StatusCode: 403}, errors.New("forbidden"), apiErrorForbidden},
+		"rate limited":        {&http.Response{StatusCode: 429}, errors.New("slow down"), apiErrorRateLimited},
+		"server error":        {&http.Response{StatusCode: 502}, errors.New("bad gateway"), apiErrorServer},
+		"bad request":         {&http.Response{StatusCode: 400}, errors.New("invalid plan"), apiErrorOther},
+		"status from body":    {nil, errors.New(`{"error":"Invalid NodePool ID","status":404}`), apiErrorNotFound},
+		"no response or body": {nil, errors.New("connection refused"), apiErrorOther},
+		"gave up retrying":    {nil, errors.New(`gave up after 1 attempts, last error: "{\"error\":\"Service unavailable\",\"status\":503}"`), apiErrorServer},
+		"success":             {&http.Response{StatusCode: 404}, nil, apiErrorOther},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := classifyAPIError(tc.resp, tc.err); got != tc.want {
+				t.Fatalf("expected %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRemoveIfGone(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+
+	d.SetId("abc")
+	if removeIfGone(context.Background(), d, &http.Response{StatusCode: 500}, errors.New("oops")) || d.Id() != "abc" {
+		t.Fatal("expected a server error to leave the resource in state")
+	}
+
+	if !removeIfGone(context.Background(), d, &http.Response{StatusCode: 404}, errors.New("gone")) || d.Id() != "" {
+		t.Fatal("expected a 404 to remove the resource from state")
+	}
+}
+
+func TestAPIErrorfDetail(t *testing.T) {
+	err := errors.New(`{"error":"Unauthorized","status":401}`)
+	diags := apiErrorf(nil, err, "error getting cluster (%s): %v", "abc", err)
+
+	if len(diags) != 1 || diags[0].Summary != `error getting cluster (abc): {"error":"Unauthorized","status":401}` {
+		t.Fatalf("unexpected summary: %v", diags)
+	}
+	if diags[0].Detail == "" {
+		t.Fatal("expected an unauthorized error to explain itself")
+	}
+}
@@ -0,0 +1,122 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vultr/terraform-provider-vultr/vultr"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &databaseCredentialsEphemeralResource{}
+
+type databaseCredentialsEphemeralResource struct {
+	client *vultr.Client
+}
+
+type databaseCredentialsModel struct {
+	DatabaseID types.String `tfsdk:"database_id"`
+	Host       types.String `tfsdk:"host"`
+	PublicHost types.String `tfsdk:"public_host"`
+	Port       types.String `tfsdk:"port"`
+	User       types.String `tfsdk:"user"`
+	Password   types.String `tfsdk:"password"`
+	DBName     types.String `tfsdk:"dbname"`
+	AccessKey  types.String `tfsdk:"access_key"`
+	AccessCert types.String `tfsdk:"access_cert"`
+}
+
+func newDatabaseCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &databaseCredentialsEphemeralResource{}
+}
+
+func (r *databaseCredentialsEphemeralResource) Metadata(
+	_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_database_credentials"
+}
+
+func (r *databaseCredentialsEphemeralResource) Schema(
+	_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "The connection details of a managed database, read at apply time without being written to state",
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the managed database",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The hostname of the managed database",
+			},
+			"public_host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public hostname of a managed database in a VPC",
+			},
+			"port": schema.StringAttribute{
+				Computed:    true,
+				Description: "The port of the managed database",
+			},
+			"user": schema.StringAttribute{
+				Computed:    true,
+				Description: "The primary admin user",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password of the primary admin user",
+			},
+			"dbname": schema.StringAttribute{
+				Computed:    true,
+				Description: "The default database",
+			},
+			"access_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key to authenticate with a Kafka database",
+			},
+			"access_cert": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The certificate to authenticate with a Kafka database",
+			},
+		},
+	}
+}
+
+func (r *databaseCredentialsEphemeralResource) Configure(
+	_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse,
+) {
+	r.client = providerClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *databaseCredentialsEphemeralResource) Open(
+	ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse,
+) {
+	var data databaseCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !requireClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	database, _, err := r.client.Govultr().Database.Get(ctx, data.DatabaseID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get managed database",
+			fmt.Sprintf("error getting database %s: %v", data.DatabaseID.ValueString(), err))
+		return
+	}
+
+	data.Host = types.StringValue(database.Host)
+	data.PublicHost = types.StringValue(database.PublicHost)
+	data.Port = types.StringValue(database.Port)
+	data.User = types.StringValue(database.User)
+	data.Password = types.StringValue(database.Password)
+	data.DBName = types.StringValue(database.DBName)
+	data.AccessKey = types.StringValue(database.AccessKey)
+	data.AccessCert = types.StringValue(database.AccessCert)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
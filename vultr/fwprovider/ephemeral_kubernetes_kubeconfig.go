@@ -0,0 +1,110 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vultr/terraform-provider-vultr/vultr"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &kubernetesKubeconfigEphemeralResource{}
+
+type kubernetesKubeconfigEphemeralResource struct {
+	client *vultr.Client
+}
+
+type kubernetesKubeconfigModel struct {
+	ClusterID            types.String `tfsdk:"cluster_id"`
+	KubeConfig           types.String `tfsdk:"kube_config"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+}
+
+func newKubernetesKubeconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &kubernetesKubeconfigEphemeralResource{}
+}
+
+func (r *kubernetesKubeconfigEphemeralResource) Metadata(
+	_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_kubeconfig"
+}
+
+func (r *kubernetesKubeconfigEphemeralResource) Schema(
+	_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "The kubeconfig of a Kubernetes cluster, read at apply time without being written to state",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the cluster",
+			},
+			"kube_config": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded kubeconfig",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the cluster's API server",
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The base64 encoded certificate of the cluster's certificate authority",
+			},
+			"client_certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The base64 encoded client certificate",
+			},
+			"client_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded client key",
+			},
+		},
+	}
+}
+
+func (r *kubernetesKubeconfigEphemeralResource) Configure(
+	_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse,
+) {
+	r.client = providerClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *kubernetesKubeconfigEphemeralResource) Open(
+	ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse,
+) {
+	var data kubernetesKubeconfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !requireClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	config, _, err := r.client.Govultr().Kubernetes.GetKubeConfig(ctx, data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get kubeconfig",
+			fmt.Sprintf("error getting kubeconfig for cluster %s: %v", data.ClusterID.ValueString(), err))
+		return
+	}
+
+	kc, err := vultr.DecodeKubeConfig(config.KubeConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to decode kubeconfig",
+			fmt.Sprintf("error decoding kubeconfig for cluster %s: %v", data.ClusterID.ValueString(), err))
+		return
+	}
+
+	data.KubeConfig = types.StringValue(config.KubeConfig)
+	data.Host = types.StringValue(kc.Clusters[0].Cluster.Server)
+	data.ClusterCACertificate = types.StringValue(kc.Clusters[0].Cluster.CaCert)
+	data.ClientCertificate = types.StringValue(kc.Users[0].User.ClientCert)
+	data.ClientKey = types.StringValue(kc.Users[0].User.ClientKey)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,91 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vultr/terraform-provider-vultr/vultr"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &objectStorageKeysEphemeralResource{}
+
+type objectStorageKeysEphemeralResource struct {
+	client *vultr.Client
+}
+
+type objectStorageKeysModel struct {
+	ObjectStorageID types.String `tfsdk:"object_storage_id"`
+	S3Hostname      types.String `tfsdk:"s3_hostname"`
+	S3AccessKey     types.String `tfsdk:"s3_access_key"`
+	S3SecretKey     types.String `tfsdk:"s3_secret_key"`
+}
+
+func newObjectStorageKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &objectStorageKeysEphemeralResource{}
+}
+
+func (r *objectStorageKeysEphemeralResource) Metadata(
+	_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_object_storage_keys"
+}
+
+func (r *objectStorageKeysEphemeralResource) Schema(
+	_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "The S3 keys of an object storage subscription, read at apply time without being written to state",
+		Attributes: map[string]schema.Attribute{
+			"object_storage_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the object storage subscription",
+			},
+			"s3_hostname": schema.StringAttribute{
+				Computed:    true,
+				Description: "The hostname of the S3 endpoint",
+			},
+			"s3_access_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The S3 access key",
+			},
+			"s3_secret_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The S3 secret key",
+			},
+		},
+	}
+}
+
+func (r *objectStorageKeysEphemeralResource) Configure(
+	_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse,
+) {
+	r.client = providerClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *objectStorageKeysEphemeralResource) Open(
+	ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse,
+) {
+	var data objectStorageKeysModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !requireClient(r.client, &resp.Diagnostics) {
+		return
+	}
+
+	obj, _, err := r.client.Govultr().ObjectStorage.Get(ctx, data.ObjectStorageID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get object storage",
+			fmt.Sprintf("error getting object storage %s: %v", data.ObjectStorageID.ValueString(), err))
+		return
+	}
+
+	data.S3Hostname = types.StringValue(obj.S3Hostname)
+	data.S3AccessKey = types.StringValue(obj.S3AccessKey)
+	data.S3SecretKey = types.StringValue(obj.S3SecretKey)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
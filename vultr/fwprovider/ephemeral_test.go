@@ -0,0 +1,180 @@
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/terraform-provider-vultr/vultr"
+	"github.com/vultr/terraform-provider-vultr/vultr/internal/fakevultr"
+)
+
+// testFakeServer returns a muxed server configured against a fake API, along
+// with a client for setting up fixtures
+func testFakeServer(t *testing.T) (tfprotov6.ProviderServer, *tfprotov6.GetProviderSchemaResponse, *govultr.Client) {
+	t.Helper()
+	ctx := context.Background()
+
+	api := fakevultr.New("fake-api-key", fakevultr.WithPendingReads(0))
+	t.Cleanup(api.Close)
+
+	factory, err := ProviderServer(ctx, vultr.Provider(), "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := factory()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: "1.11.0",
+		Config: testConfig(t, schemaResp.Provider, map[string]tftypes.Value{
+			"api_key":      tftypes.NewValue(tftypes.String, api.APIKey),
+			"api_endpoint": tftypes.NewValue(tftypes.String, api.URL()),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+
+	config := vultr.Config{APIKey: api.APIKey, APIEndpoint: api.URL()}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return server, schemaResp, client.Govultr()
+}
+
+// testOpenEphemeral opens an ephemeral resource and returns its result
+func testOpenEphemeral(
+	t *testing.T,
+	server tfprotov6.ProviderServer,
+	schemaResp *tfprotov6.GetProviderSchemaResponse,
+	typeName string,
+	values map[string]tftypes.Value,
+) map[string]tftypes.Value {
+	t.Helper()
+
+	s, ok := schemaResp.EphemeralResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("expected %s to be served", typeName)
+	}
+
+	resp, err := server.OpenEphemeralResource(context.Background(), &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: typeName,
+		Config:   testConfig(t, s, values),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+
+	result, err := resp.Result.Unmarshal(s.ValueType())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := result.As(&attrs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return attrs
+}
+
+func testString(t *testing.T, v tftypes.Value) string {
+	t.Helper()
+
+	var s string
+	if err := v.As(&s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return s
+}
+
+func TestKubernetesKubeconfigEphemeralResource(t *testing.T) {
+	server, schemaResp, client := testFakeServer(t)
+
+	cluster, _, err := client.Kubernetes.CreateCluster(context.Background(), &govultr.ClusterReq{
+		Label: "ephemeral", Region: "ewr", Version: "v1.31.0+1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	attrs := testOpenEphemeral(t, server, schemaResp, "vultr_kubernetes_kubeconfig", map[string]tftypes.Value{
+		"cluster_id": tftypes.NewValue(tftypes.String, cluster.ID),
+	})
+
+	config, _, err := client.Kubernetes.GetKubeConfig(context.Background(), cluster.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := testString(t, attrs["kube_config"]); got != config.KubeConfig {
+		t.Errorf("expected the cluster's kubeconfig, got %q", got)
+	}
+	if got := testString(t, attrs["host"]); got == "" {
+		t.Error("expected the API server from the kubeconfig")
+	}
+	if got := testString(t, attrs["client_key"]); got == "" {
+		t.Error("expected the client key from the kubeconfig")
+	}
+}
+
+func TestDatabaseCredentialsEphemeralResource(t *testing.T) {
+	server, schemaResp, client := testFakeServer(t)
+
+	database, _, err := client.Database.Create(context.Background(), &govultr.DatabaseCreateReq{
+		DatabaseEngine:        "pg",
+		DatabaseEngineVersion: "16",
+		Region:                "ewr",
+		Plan:                  "vultr-dbaas-startup-cc-1-55-2",
+		Label:                 "ephemeral",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	attrs := testOpenEphemeral(t, server, schemaResp, "vultr_database_credentials", map[string]tftypes.Value{
+		"database_id": tftypes.NewValue(tftypes.String, database.ID),
+	})
+
+	for attr, want := range map[string]string{
+		"host":     database.Host,
+		"port":     database.Port,
+		"user":     database.User,
+		"password": database.Password,
+	} {
+		if got := testString(t, attrs[attr]); got != want {
+			t.Errorf("expected %s to be %q, got %q", attr, want, got)
+		}
+	}
+}
+
+func TestEphemeralResourceMissing(t *testing.T) {
+	server, schemaResp, _ := testFakeServer(t)
+	s := schemaResp.EphemeralResourceSchemas["vultr_object_storage_keys"]
+
+	resp, err := server.OpenEphemeralResource(context.Background(), &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "vultr_object_storage_keys",
+		Config: testConfig(t, s, map[string]tftypes.Value{
+			"object_storage_id": tftypes.NewValue(tftypes.String, "missing"),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Diagnostics) == 0 {
+		t.Fatal("expected an error for an object storage subscription that doesn't exist")
+	}
+}
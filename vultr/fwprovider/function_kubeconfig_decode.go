@@ -0,0 +1,81 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vultr/terraform-provider-vultr/vultr"
+)
+
+var _ function.Function = &kubeconfigDecodeFunction{}
+
+type kubeconfigDecodeFunction struct{}
+
+type kubeconfigDecodeModel struct {
+	ClusterName          types.String `tfsdk:"cluster_name"`
+	Server               types.String `tfsdk:"server"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+}
+
+func newKubeconfigDecodeFunction() function.Function {
+	return &kubeconfigDecodeFunction{}
+}
+
+func (f *kubeconfigDecodeFunction) Metadata(
+	_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse,
+) {
+	resp.Name = "kubeconfig_decode"
+}
+
+func (f *kubeconfigDecodeFunction) Definition(
+	_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Decode the kubeconfig of a Kubernetes cluster",
+		MarkdownDescription: "Decodes the base64 encoded kubeconfig of a Kubernetes cluster, such as the " +
+			"`kube_config` attribute of `vultr_kubernetes`, into the server, certificates and key needed to " +
+			"configure the Kubernetes and Helm providers.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "kubeconfig",
+				MarkdownDescription: "The base64 encoded kubeconfig",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"cluster_name":           types.StringType,
+				"server":                 types.StringType,
+				"cluster_ca_certificate": types.StringType,
+				"client_certificate":     types.StringType,
+				"client_key":             types.StringType,
+			},
+		},
+	}
+}
+
+func (f *kubeconfigDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var kubeconfig string
+	resp.Error = req.Arguments.Get(ctx, &kubeconfig)
+	if resp.Error != nil {
+		return
+	}
+
+	kc, err := vultr.DecodeKubeConfig(kubeconfig)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to decode kubeconfig: %v", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, kubeconfigDecodeModel{
+		ClusterName:          types.StringValue(kc.Clusters[0].Name),
+		Server:               types.StringValue(kc.Clusters[0].Cluster.Server),
+		ClusterCACertificate: types.StringValue(kc.Clusters[0].Cluster.CaCert),
+		ClientCertificate:    types.StringValue(kc.Users[0].User.ClientCert),
+		ClientKey:            types.StringValue(kc.Users[0].User.ClientKey),
+	})
+}
@@ -0,0 +1,72 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vultr/terraform-provider-vultr/vultr"
+)
+
+var _ function.Function = &parseImportIDFunction{}
+
+type parseImportIDFunction struct{}
+
+func newParseImportIDFunction() function.Function {
+	return &parseImportIDFunction{}
+}
+
+func (f *parseImportIDFunction) Metadata(
+	_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse,
+) {
+	resp.Name = "parse_import_id"
+}
+
+func (f *parseImportIDFunction) Definition(
+	_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Split a composite import ID into its parts",
+		MarkdownDescription: "Splits the composite ID a resource is imported with into a map of its named parts, " +
+			"the same way the resource's importer does. Supported resource types are " +
+			fmt.Sprintf("`%s`.", strings.Join(vultr.ImportIDResourceTypes(), "`, `")),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "The resource type the ID is imported into, such as `vultr_firewall_rule`",
+			},
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The composite import ID",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *parseImportIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType, id string
+	resp.Error = req.Arguments.Get(ctx, &resourceType, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	if !slices.Contains(vultr.ImportIDResourceTypes(), resourceType) {
+		resp.Error = function.NewArgumentFuncError(0,
+			fmt.Sprintf("%s is not imported with a composite ID", resourceType))
+		return
+	}
+
+	parts, err := vultr.ParseImportID(resourceType, id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, parts)
+}
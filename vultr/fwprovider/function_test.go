@@ -0,0 +1,172 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/vultr/terraform-provider-vultr/vultr"
+)
+
+// testCallFunction calls a provider function with string arguments and
+// returns its result, or the error it reported
+func testCallFunction(
+	t *testing.T, name string, args ...string,
+) (map[string]tftypes.Value, *tfprotov6.FunctionError) {
+	t.Helper()
+	ctx := context.Background()
+
+	factory, err := ProviderServer(ctx, vultr.Provider(), "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := factory()
+
+	// Terraform discovers functions through the provider schema before
+	// calling them
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	definition, ok := schemaResp.Functions[name]
+	if !ok {
+		t.Fatalf("expected %s to be served", name)
+	}
+
+	arguments := make([]*tfprotov6.DynamicValue, 0, len(args))
+	for _, a := range args {
+		v, err := tfprotov6.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, a))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		arguments = append(arguments, &v)
+	}
+
+	resp, err := server.CallFunction(ctx, &tfprotov6.CallFunctionRequest{Name: name, Arguments: arguments})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+
+	result, err := resp.Result.Unmarshal(definition.Return.Type)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := result.As(&attrs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return attrs, nil
+}
+
+func TestKubeconfigDecodeFunction(t *testing.T) {
+	kubeconfig := base64.StdEncoding.EncodeToString([]byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: vke-test
+  cluster:
+    certificate-authority-data: Y2EtY2VydA==
+    server: https://vke-test.vultr-k8s.com:6443
+users:
+- name: admin
+  user:
+    client-certificate-data: Y2xpZW50LWNlcnQ=
+    client-key-data: Y2xpZW50LWtleQ==
+`))
+
+	attrs, funcErr := testCallFunction(t, "kubeconfig_decode", kubeconfig)
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr.Text)
+	}
+
+	expected := map[string]string{
+		"cluster_name":           "vke-test",
+		"server":                 "https://vke-test.vultr-k8s.com:6443",
+		"cluster_ca_certificate": "Y2EtY2VydA==",
+		"client_certificate":     "Y2xpZW50LWNlcnQ=",
+		"client_key":             "Y2xpZW50LWtleQ==",
+	}
+	for name, want := range expected {
+		if got := testString(t, attrs[name]); got != want {
+			t.Errorf("expected %s to be %q, got %q", name, want, got)
+		}
+	}
+
+	_, funcErr = testCallFunction(t, "kubeconfig_decode", "not a kubeconfig")
+	if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 0 {
+		t.Errorf("expected an error for the kubeconfig argument, got %#v", funcErr)
+	}
+}
+
+func TestParseImportIDFunction(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		id           string
+		expected     map[string]string
+		errArgument  int64
+	}{
+		{
+			resourceType: "vultr_firewall_rule",
+			id:           "b6a1b2a3-98d9-4e0b-8b43-1bdc7a7b5a4e,3",
+			expected:     map[string]string{"firewall_group_id": "b6a1b2a3-98d9-4e0b-8b43-1bdc7a7b5a4e", "id": "3"},
+		},
+		{
+			resourceType: "vultr_dns_record",
+			id:           "example.com,cb676a46-66fd-4dfb-b839-443f2e6c0b60",
+			expected:     map[string]string{"domain": "example.com", "id": "cb676a46-66fd-4dfb-b839-443f2e6c0b60"},
+		},
+		{
+			resourceType: "vultr_kubernetes_node_pools",
+			id:           "7365a98b-5a43-450f-bd27-d768827100e5 ec330340-4f50-4526-858f-2bb5e3ab3a5a",
+			expected: map[string]string{
+				"cluster_id": "7365a98b-5a43-450f-bd27-d768827100e5", "id": "ec330340-4f50-4526-858f-2bb5e3ab3a5a",
+			},
+		},
+		{
+			resourceType: "vultr_instance_vpc_attachment",
+			id:           "7365a98b-5a43-450f-bd27-d768827100e5,0a5e1ba9-1a54-4cb1-9c4b-d2d7d2a1e8bd",
+			expected: map[string]string{
+				"instance_id": "7365a98b-5a43-450f-bd27-d768827100e5", "vpc_id": "0a5e1ba9-1a54-4cb1-9c4b-d2d7d2a1e8bd",
+			},
+		},
+		{
+			resourceType: "vultr_database_quota",
+			id:           "b6a1b2a3-98d9-4e0b-8b43-1bdc7a7b5a4e|my_client|my_user",
+			expected: map[string]string{
+				"database_id": "b6a1b2a3-98d9-4e0b-8b43-1bdc7a7b5a4e", "client_id": "my_client", "user": "my_user",
+			},
+		},
+		{resourceType: "vultr_kubernetes_node_pools", id: "7365a98b-5a43-450f-bd27-d768827100e5", errArgument: 1},
+		{resourceType: "vultr_dns_record", id: ",cb676a46-66fd-4dfb-b839-443f2e6c0b60", errArgument: 1},
+		{resourceType: "vultr_instance", id: "a,b", errArgument: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType+"/"+tt.id, func(t *testing.T) {
+			attrs, funcErr := testCallFunction(t, "parse_import_id", tt.resourceType, tt.id)
+			if tt.expected == nil {
+				if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != tt.errArgument {
+					t.Fatalf("expected an error for argument %d, got %#v", tt.errArgument, funcErr)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr.Text)
+			}
+
+			if len(attrs) != len(tt.expected) {
+				t.Errorf("expected %d parts, got %d", len(tt.expected), len(attrs))
+			}
+			for name, want := range tt.expected {
+				if got := testString(t, attrs[name]); got != want {
+					t.Errorf("expected %s to be %q, got %q", name, want, got)
+				}
+			}
+		})
+	}
+}
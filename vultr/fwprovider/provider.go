@@ -0,0 +1,128 @@
+// Package fwprovider is the terraform-plugin-framework half of the Vultr
+// provider. It is muxed with the SDKv2 provider in package vultr so that
+// features only the framework supports can be added, and resources moved
+// over, one at a time.
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/terraform-provider-vultr/vultr"
+)
+
+var (
+	_ provider.Provider                       = &vultrProvider{}
+	_ provider.ProviderWithEphemeralResources = &vultrProvider{}
+	_ provider.ProviderWithFunctions          = &vultrProvider{}
+)
+
+type vultrProvider struct {
+	version string
+
+	// sdkProvider is the SDKv2 half of the provider, whose client is shared
+	sdkProvider *schema.Provider
+}
+
+// New returns the framework provider that is muxed with sdkProvider
+func New(version string, sdkProvider *schema.Provider) func() provider.Provider {
+	return func() provider.Provider {
+		return &vultrProvider{version: version, sdkProvider: sdkProvider}
+	}
+}
+
+func (p *vultrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "vultr"
+	resp.Version = p.version
+}
+
+// Schema is converted from the SDKv2 provider schema, as the mux server
+// requires both halves to have identical provider blocks
+func (p *vultrProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	s, err := providerSchema(p.sdkProvider.Schema)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to convert the provider schema", err.Error())
+		return
+	}
+	resp.Schema = s
+}
+
+// Configure shares the client of the SDKv2 provider, which the mux server
+// configures first, so that the provider block is read, the API key checked
+// and the API rate limited once for both halves
+func (p *vultrProvider) Configure(
+	_ context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse,
+) {
+	// Resources report a missing client themselves if they are used before
+	// the provider block is known
+	if !req.Config.Raw.IsFullyKnown() || p.sdkProvider.Meta() == nil {
+		return
+	}
+
+	client, ok := p.sdkProvider.Meta().(*vultr.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unable to configure the provider",
+			fmt.Sprintf("unexpected client type %T", p.sdkProvider.Meta()))
+		return
+	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+}
+
+func (p *vultrProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *vultrProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *vultrProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newDatabaseCredentialsEphemeralResource,
+		newKubernetesKubeconfigEphemeralResource,
+		newObjectStorageKeysEphemeralResource,
+	}
+}
+
+func (p *vultrProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		newKubeconfigDecodeFunction,
+		newParseImportIDFunction,
+	}
+}
+
+// providerClient returns the client passed to the Configure method of a
+// resource, which is nil until the provider block is known
+func providerClient(providerData any, diags *diag.Diagnostics) *vultr.Client {
+	if providerData == nil {
+		return nil
+	}
+
+	client, ok := providerData.(*vultr.Client)
+	if !ok {
+		diags.AddError("Unexpected provider data", fmt.Sprintf("expected *vultr.Client, got %T", providerData))
+		return nil
+	}
+	return client
+}
+
+// requireClient reports an error when a resource is used before the provider
+// has been configured
+func requireClient(client *vultr.Client, diags *diag.Diagnostics) bool {
+	if client == nil {
+		diags.AddError("Provider not configured",
+			"The Vultr provider must be configured, with a known provider block, before it can make API calls.")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,116 @@
+package fwprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/vultr/terraform-provider-vultr/vultr"
+)
+
+// testConfig builds a provider or resource block with the given attributes set
+// and everything else null
+func testConfig(t *testing.T, s *tfprotov6.Schema, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	typ := s.ValueType().(tftypes.Object)
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range typ.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	for _, b := range s.Block.BlockTypes {
+		attrs[b.TypeName] = tftypes.NewValue(typ.AttributeTypes[b.TypeName], []tftypes.Value{})
+	}
+
+	config, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, attrs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &config
+}
+
+func TestProviderServerSchema(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := ProviderServer(ctx, vultr.Provider(), "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp, err := server().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	if _, ok := resp.ResourceSchemas["vultr_instance"]; !ok {
+		t.Error("expected the SDKv2 resources to be served")
+	}
+}
+
+func TestProviderServerConfigure(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var auth []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		auth = append(auth, r.Header.Get("Authorization"))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"account":{"name":"mock"}}`))
+	}))
+	defer api.Close()
+
+	sdkProvider := vultr.Provider()
+	server, err := ProviderServer(ctx, sdkProvider, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	schemaResp, err := server().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp, err := server().ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: "1.11.0",
+		Config: testConfig(t, schemaResp.Provider, map[string]tftypes.Value{
+			"api_key":      tftypes.NewValue(tftypes.String, "test-key"),
+			"api_endpoint": tftypes.NewValue(tftypes.String, api.URL),
+			"retry_limit":  tftypes.NewValue(tftypes.Number, 1),
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+
+	if _, ok := sdkProvider.Meta().(*vultr.Client); !ok {
+		t.Error("expected the SDKv2 provider to be configured")
+	}
+
+	// The framework half shares the SDKv2 client, so the API key is only
+	// checked once, with the key and endpoint from the provider block
+	if len(auth) != 1 {
+		t.Fatalf("expected a single credential check for both halves of the provider, got %d", len(auth))
+	}
+	for _, a := range auth {
+		if a != "Bearer test-key" {
+			t.Errorf("expected the configured API key, got %q", a)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package fwprovider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// providerSchema converts the SDKv2 provider schema. Only the types used by
+// provider arguments are supported: primitives, lists and sets of primitives,
+// and nested blocks.
+func providerSchema(sdk map[string]*sdkschema.Schema) (schema.Schema, error) {
+	attributes, blocks, err := providerAttributes(sdk)
+	if err != nil {
+		return schema.Schema{}, err
+	}
+
+	return schema.Schema{Attributes: attributes, Blocks: blocks}, nil
+}
+
+func providerAttributes(
+	sdk map[string]*sdkschema.Schema,
+) (map[string]schema.Attribute, map[string]schema.Block, error) {
+	attributes := map[string]schema.Attribute{}
+	blocks := map[string]schema.Block{}
+
+	for name, s := range sdk {
+		deprecation := s.Deprecated
+
+		if r, ok := s.Elem.(*sdkschema.Resource); ok {
+			nestedAttributes, nestedBlocks, err := providerAttributes(r.Schema)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s.%v", name, err)
+			}
+
+			nested := schema.NestedBlockObject{Attributes: nestedAttributes, Blocks: nestedBlocks}
+			switch s.Type {
+			case sdkschema.TypeList:
+				blocks[name] = schema.ListNestedBlock{
+					NestedObject: nested, Description: s.Description, DeprecationMessage: deprecation,
+				}
+			case sdkschema.TypeSet:
+				blocks[name] = schema.SetNestedBlock{
+					NestedObject: nested, Description: s.Description, DeprecationMessage: deprecation,
+				}
+			default:
+				return nil, nil, fmt.Errorf("%s: unsupported block type %s", name, s.Type)
+			}
+			continue
+		}
+
+		switch s.Type {
+		case sdkschema.TypeString:
+			attributes[name] = schema.StringAttribute{
+				Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive,
+				Description: s.Description, DeprecationMessage: deprecation,
+			}
+		case sdkschema.TypeInt:
+			attributes[name] = schema.Int64Attribute{
+				Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive,
+				Description: s.Description, DeprecationMessage: deprecation,
+			}
+		case sdkschema.TypeFloat:
+			attributes[name] = schema.Float64Attribute{
+				Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive,
+				Description: s.Description, DeprecationMessage: deprecation,
+			}
+		case sdkschema.TypeBool:
+			attributes[name] = schema.BoolAttribute{
+				Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive,
+				Description: s.Description, DeprecationMessage: deprecation,
+			}
+		case sdkschema.TypeList, sdkschema.TypeSet:
+			elem, err := primitiveType(s.Elem)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s: %v", name, err)
+			}
+
+			if s.Type == sdkschema.TypeList {
+				attributes[name] = schema.ListAttribute{
+					ElementType: elem, Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive,
+					Description: s.Description, DeprecationMessage: deprecation,
+				}
+			} else {
+				attributes[name] = schema.SetAttribute{
+					ElementType: elem, Optional: s.Optional, Required: s.Required, Sensitive: s.Sensitive,
+					Description: s.Description, DeprecationMessage: deprecation,
+				}
+			}
+		default:
+			return nil, nil, fmt.Errorf("%s: unsupported attribute type %s", name, s.Type)
+		}
+	}
+
+	return attributes, blocks, nil
+}
+
+func primitiveType(elem interface{}) (attr.Type, error) {
+	s, ok := elem.(*sdkschema.Schema)
+	if !ok {
+		return nil, fmt.Errorf("unsupported element %T", elem)
+	}
+
+	switch s.Type {
+	case sdkschema.TypeString:
+		return types.StringType, nil
+	case sdkschema.TypeInt:
+		return types.Int64Type, nil
+	case sdkschema.TypeFloat:
+		return types.Float64Type, nil
+	case sdkschema.TypeBool:
+		return types.BoolType, nil
+	default:
+		return nil, fmt.Errorf("unsupported element type %s", s.Type)
+	}
+}
@@ -0,0 +1,33 @@
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderServer muxes the SDKv2 provider, upgraded to protocol 6, with the
+// framework provider. Each resource, data source and function is served by
+// whichever half defines it.
+func ProviderServer(
+	ctx context.Context, sdkProvider *schema.Provider, version string,
+) (func() tfprotov6.ProviderServer, error) {
+	upgraded, err := tf5to6server.UpgradeServer(ctx, sdkProvider.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	mux, err := tf6muxserver.NewMuxServer(ctx,
+		func() tfprotov6.ProviderServer { return upgraded },
+		providerserver.NewProtocol6(New(version, sdkProvider)()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mux.ProviderServer, nil
+}
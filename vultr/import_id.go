@@ -0,0 +1,79 @@
+package vultr
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// importIDFormat is the composite ID a resource is imported with: its parts
+// joined by a separator
+type importIDFormat struct {
+	separator string
+	parts     []string
+	example   string
+}
+
+var importIDFormats = map[string]importIDFormat{
+	"vultr_database_quota": {
+		separator: "|",
+		parts:     []string{"database_id", "client_id", "user"},
+		example:   "databaseID|clientID|user",
+	},
+	"vultr_dns_record": {
+		separator: ",",
+		parts:     []string{"domain", "id"},
+		example:   "domain,resourceID",
+	},
+	"vultr_firewall_rule": {
+		separator: ",",
+		parts:     []string{"firewall_group_id", "id"},
+		example:   "firewallGroupID,firewallRuleID",
+	},
+	"vultr_instance_vpc_attachment": {
+		separator: ",",
+		parts:     []string{"instance_id", "vpc_id"},
+		example:   "instanceID,vpcID",
+	},
+	"vultr_kubernetes_node_pools": {
+		separator: " ",
+		parts:     []string{"cluster_id", "id"},
+		example:   "clusterID nodePoolID",
+	},
+}
+
+// ImportIDResourceTypes returns the resource types imported with a composite
+// ID, in sorted order
+func ImportIDResourceTypes() []string {
+	types := make([]string, 0, len(importIDFormats))
+	for t := range importIDFormats {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// ParseImportID splits the composite import ID of a resource type into its
+// named parts, the same way the resource's importer does
+func ParseImportID(resourceType, id string) (map[string]string, error) {
+	format, ok := importIDFormats[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("%s is not imported with a composite ID, expected one of %s",
+			resourceType, strings.Join(ImportIDResourceTypes(), ", "))
+	}
+
+	values := strings.SplitN(id, format.separator, len(format.parts))
+	if len(values) != len(format.parts) {
+		return nil, fmt.Errorf("invalid import format %q, expected %q", id, format.example)
+	}
+
+	parts := make(map[string]string, len(values))
+	for i, v := range values {
+		if v == "" {
+			return nil, fmt.Errorf("invalid import format %q, expected %q", id, format.example)
+		}
+		parts[format.parts[i]] = v
+	}
+
+	return parts, nil
+}
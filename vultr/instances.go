@@ -7,60 +7,44 @@ import (
 	"github.com/vultr/govultr/v3"
 )
 
-func getVPCs(client *govultr.Client, instanceID string) ([]string, error) {
-	options := &govultr.ListOptions{}
-	var vpcs []string
-	for {
-		vpcInfo, meta, _, err := client.Instance.ListVPCInfo(context.Background(), instanceID, options)
-		if err != nil {
-			return nil, fmt.Errorf("error getting list of attached VPCs: %v", err)
-		}
-
-		if len(vpcInfo) == 0 {
-			break
-		}
-
-		for _, v := range vpcInfo {
-			vpcs = append(vpcs, v.ID)
-		}
+func getVPCs(ctx context.Context, client *govultr.Client, instanceID string) ([]string, error) {
+	vpcInfo, err := listAll(ctx, nil, func(v govultr.VPCInfo) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.VPCInfo, *govultr.Meta, error) {
+			vpcInfo, meta, _, err := client.Instance.ListVPCInfo(ctx, instanceID, opts)
+			return vpcInfo, meta, err
+		})
+	if err != nil {
+		return nil, fmt.Errorf("error getting list of attached VPCs: %v", err)
+	}
 
-		if meta.Links.Next == "" {
-			break
-		}
-		options.Cursor = meta.Links.Next
+	var vpcs []string
+	for _, v := range vpcInfo {
+		vpcs = append(vpcs, v.ID)
 	}
 	return vpcs, nil
 }
 
-func getVPC2s(client *govultr.Client, instanceID string) ([]string, error) {
-	options := &govultr.ListOptions{}
-	var vpcs []string
-	for {
-		vpcInfo, meta, _, err := client.Instance.ListVPC2Info(context.Background(), instanceID, options)
-		if err != nil {
-			return nil, fmt.Errorf("error getting list of attached VPCs 2.0: %v", err)
-		}
-
-		if len(vpcInfo) == 0 {
-			break
-		}
-
-		for _, v := range vpcInfo {
-			vpcs = append(vpcs, v.ID)
-		}
+func getVPC2s(ctx context.Context, client *govultr.Client, instanceID string) ([]string, error) {
+	vpcInfo, err := listAll(ctx, nil, func(v govultr.VPC2Info) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.VPC2Info, *govultr.Meta, error) {
+			vpcInfo, meta, _, err := client.Instance.ListVPC2Info(ctx, instanceID, opts)
+			return vpcInfo, meta, err
+		})
+	if err != nil {
+		return nil, fmt.Errorf("error getting list of attached VPCs 2.0: %v", err)
+	}
 
-		if meta.Links.Next == "" {
-			break
-		}
-		options.Cursor = meta.Links.Next
+	var vpcs []string
+	for _, v := range vpcInfo {
+		vpcs = append(vpcs, v.ID)
 	}
 	return vpcs, nil
 }
 
-func getBareMetalServerVPC2s(client *govultr.Client, serverID string) ([]string, error) {
+func getBareMetalServerVPC2s(ctx context.Context, client *govultr.Client, serverID string) ([]string, error) {
 	var vpcs []string
 
-	vpcInfo, _, err := client.BareMetalServer.ListVPC2Info(context.Background(), serverID)
+	vpcInfo, _, err := client.BareMetalServer.ListVPC2Info(ctx, serverID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting list of attached VPCs 2.0: %v", err)
 	}
@@ -71,3 +55,21 @@ func getBareMetalServerVPC2s(client *govultr.Client, serverID string) ([]string,
 
 	return vpcs, nil
 }
+
+// listInstances returns every instance matching opts
+func listInstances(ctx context.Context, client *govultr.Client, opts *govultr.ListOptions) ([]govultr.Instance, error) {
+	return listAll(ctx, opts, func(v govultr.Instance) string { return v.ID },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.Instance, *govultr.Meta, error) {
+			instances, meta, _, err := client.Instance.List(ctx, opts)
+			return instances, meta, err
+		})
+}
+
+// listInstanceIPv4s returns every IPv4 address assigned to an instance
+func listInstanceIPv4s(ctx context.Context, client *govultr.Client, instanceID string) ([]govultr.IPv4, error) {
+	return listAll(ctx, nil, func(v govultr.IPv4) string { return v.IP },
+		func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.IPv4, *govultr.Meta, error) {
+			ips, meta, _, err := client.Instance.ListIPv4(ctx, instanceID, opts)
+			return ips, meta, err
+		})
+}
@@ -0,0 +1,266 @@
+// Package cassette records HTTP interactions with the Vultr API to disk and
+// replays them later, so acceptance tests can be rerun offline and
+// deterministically. Secrets are scrubbed from everything that is written to
+// a cassette.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Mode selects whether a cassette talks to the API or serves saved responses
+type Mode string
+
+const (
+	// ModeRecord sends requests to the API and saves the scrubbed interactions
+	ModeRecord Mode = "record"
+	// ModeReplay serves responses from a previously recorded cassette
+	ModeReplay Mode = "replay"
+)
+
+// ParseMode validates a mode string, typically read from the environment
+func ParseMode(s string) (Mode, error) {
+	switch m := Mode(s); m {
+	case ModeRecord, ModeReplay:
+		return m, nil
+	default:
+		return "", fmt.Errorf("invalid cassette mode %q: must be %q or %q", s, ModeRecord, ModeReplay)
+	}
+}
+
+// Request is the recorded part of an outgoing request
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is the recorded part of an API response
+type Response struct {
+	StatusCode int               `json:"status_code"`
+	Header     map[string]string `json:"header,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// Interaction is a single request/response pair
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type file struct {
+	Seed         int64         `json:"seed"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// recordedHeaders are the response headers worth keeping in a cassette
+var recordedHeaders = []string{"Content-Type", "Retry-After"}
+
+// ErrNotFound is returned by Load when no cassette has been recorded yet
+var ErrNotFound = errors.New("cassette not found")
+
+// Cassette holds the interactions of a single test. It is safe to share
+// between the several provider instances a test configures.
+type Cassette struct {
+	mode Mode
+	path string
+	seed int64
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// New starts an empty cassette that will be written to path by Save
+func New(path string) *Cassette {
+	return &Cassette{
+		mode: ModeRecord,
+		path: path,
+		seed: time.Now().UnixNano(),
+	}
+}
+
+// Load reads a previously recorded cassette for replay. ErrNotFound is
+// returned if nothing has been recorded at path.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("error reading cassette %s: %v", path, err)
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("error parsing cassette %s: %v", path, err)
+	}
+
+	return &Cassette{
+		mode:         ModeReplay,
+		path:         path,
+		seed:         f.Seed,
+		interactions: f.Interactions,
+		used:         make([]bool, len(f.Interactions)),
+	}, nil
+}
+
+// Open records a new cassette or loads an existing one depending on mode
+func Open(path string, mode Mode) (*Cassette, error) {
+	if mode == ModeReplay {
+		return Load(path)
+	}
+	return New(path), nil
+}
+
+// Mode returns whether the cassette is recording or replaying
+func (c *Cassette) Mode() Mode {
+	return c.mode
+}
+
+// Rand returns a random source seeded identically when recording and
+// replaying, so generated resource names match the recorded requests.
+func (c *Cassette) Rand() *rand.Rand {
+	return rand.New(rand.NewSource(c.seed))
+}
+
+// Save writes the recorded interactions to disk. It does nothing when
+// replaying.
+func (c *Cassette) Save() error {
+	if c.mode != ModeRecord {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(file{Seed: c.seed, Interactions: c.interactions}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding cassette %s: %v", c.path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("error creating cassette directory: %v", err)
+	}
+
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// Transport returns a RoundTripper that records requests sent through next,
+// or serves them from the cassette without calling next when replaying.
+func (c *Cassette) Transport(next http.RoundTripper) http.RoundTripper {
+	return &transport{cassette: c, next: next}
+}
+
+type transport struct {
+	cassette *Cassette
+	next     http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: error reading request body: %v", err)
+	}
+
+	recorded := Request{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Body:   Scrub(body),
+	}
+
+	if t.cassette.mode == ModeReplay {
+		return t.cassette.replay(req, recorded)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: error reading response body: %v", err)
+	}
+
+	header := map[string]string{}
+	for _, h := range recordedHeaders {
+		if v := resp.Header.Get(h); v != "" {
+			header[h] = v
+		}
+	}
+
+	t.cassette.mu.Lock()
+	t.cassette.interactions = append(t.cassette.interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       Scrub(respBody),
+		},
+	})
+	t.cassette.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay serves the first unused interaction matching the request. Matching
+// on the scrubbed body keeps concurrent creates of the same resource type
+// apart.
+func (c *Cassette) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, in := range c.interactions {
+		if c.used[i] || in.Request != recorded {
+			continue
+		}
+		c.used[i] = true
+
+		resp := &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{},
+			Body:          io.NopCloser(bytes.NewBufferString(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}
+		for k, v := range in.Response.Header {
+			resp.Header.Set(k, v)
+		}
+
+		return resp, nil
+	}
+
+	return nil, fmt.Errorf("cassette: no recorded interaction for %s %s in %s", recorded.Method, recorded.URL, c.path)
+}
+
+// readBody drains body and replaces it with a re-readable copy
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return "", err
+	}
+
+	*body = io.NopCloser(bytes.NewReader(data))
+	return string(data), nil
+}
@@ -0,0 +1,149 @@
+package cassette
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "abc")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"instance":{"id":"1","label":"` + labelOf(string(body)) + `","default_password":"hunter2"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"instance":{"id":"1","status":"active"}}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "TestRecordAndReplay.json")
+
+	rec := New(path)
+	client := &http.Client{Transport: rec.Transport(http.DefaultTransport)}
+
+	post := func(c *http.Client, label string) string {
+		t.Helper()
+		resp, err := c.Post(server.URL+"/v2/instances", "application/json", strings.NewReader(`{"label":"`+label+`","user_data":"x"}`))
+		if err != nil {
+			t.Fatalf("error posting: %v", err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	if got := post(client, "a"); !strings.Contains(got, "hunter2") {
+		t.Fatalf("expected the live response to be untouched, got %s", got)
+	}
+	post(client, "b")
+	if _, err := client.Get(server.URL + "/v2/instances/1"); err != nil {
+		t.Fatalf("error getting: %v", err)
+	}
+
+	if err := rec.Save(); err != nil {
+		t.Fatalf("error saving cassette: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("error reading cassette: %v", err)
+	}
+	if strings.Contains(string(data), "hunter2") {
+		t.Fatal("expected default_password to be redacted from the cassette")
+	}
+	if strings.Contains(string(data), "X-Request-Id") {
+		t.Fatal("expected only allow-listed headers to be recorded")
+	}
+
+	recordedCalls := calls
+	replay, err := Load(path)
+	if err != nil {
+		t.Fatalf("error loading cassette: %v", err)
+	}
+	client = &http.Client{Transport: replay.Transport(http.DefaultTransport)}
+
+	// Requests are matched on their body, so the order may differ from the
+	// recording.
+	if got := post(client, "b"); !strings.Contains(got, `"label":"b"`) || !strings.Contains(got, Redacted) {
+		t.Fatalf("unexpected replayed response %s", got)
+	}
+	if got := post(client, "a"); !strings.Contains(got, `"label":"a"`) {
+		t.Fatalf("unexpected replayed response %s", got)
+	}
+
+	resp, err := client.Get(server.URL + "/v2/instances/1")
+	if err != nil {
+		t.Fatalf("error replaying get: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("unexpected replayed response %d %v", resp.StatusCode, resp.Header)
+	}
+
+	if _, err := client.Get(server.URL + "/v2/instances/1"); err == nil {
+		t.Fatal("expected an error once the recorded interactions are used up")
+	}
+
+	if calls != recordedCalls {
+		t.Fatalf("expected replay to stay offline, server saw %d extra calls", calls-recordedCalls)
+	}
+
+	if replay.Rand().Int63() != rec.Rand().Int63() {
+		t.Fatal("expected replay to reuse the recorded random seed")
+	}
+}
+
+func labelOf(body string) string {
+	if strings.Contains(body, `"label":"b"`) {
+		return "b"
+	}
+	return "a"
+}
+
+func TestLoadMissing(t *testing.T) {
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.json")); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestScrub(t *testing.T) {
+	kubeconfig := base64.StdEncoding.EncodeToString([]byte("real"))
+
+	got := Scrub(`{"database":{"password":"p","port":"5432","users":[{"username":"u","password":"q"}]},` +
+		`"s3_secret_key":"s","kube_config":"` + kubeconfig + `","count":12345678901234}`)
+
+	for _, secret := range []string{`"p"`, `"q"`, `"s"`, kubeconfig} {
+		if strings.Contains(got, secret) {
+			t.Fatalf("expected %s to be redacted from %s", secret, got)
+		}
+	}
+	for _, kept := range []string{`"port":"5432"`, `"username":"u"`, `"count":12345678901234`} {
+		if !strings.Contains(got, kept) {
+			t.Fatalf("expected %s to be kept in %s", kept, got)
+		}
+	}
+
+	if Scrub("not json") != "not json" {
+		t.Fatal("expected non-JSON bodies to be returned unchanged")
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	if m, err := ParseMode("replay"); err != nil || m != ModeReplay {
+		t.Fatalf("unexpected result %q %v", m, err)
+	}
+	if _, err := ParseMode("rewind"); err == nil {
+		t.Fatal("expected an error for an unknown mode")
+	}
+}
@@ -0,0 +1,93 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+)
+
+// Redacted replaces secret values in recorded request and response bodies
+const Redacted = "REDACTED"
+
+// secretFields are the JSON fields whose values never reach a cassette
+var secretFields = map[string]bool{
+	"access_cert":      true,
+	"access_key":       true,
+	"api_key":          true,
+	"default_password": true,
+	"password":         true,
+	"private_key":      true,
+	"private_key_b64":  true,
+	"s3_access_key":    true,
+	"s3_secret_key":    true,
+	"ssl_cert_key":     true,
+}
+
+// redactedKubeConfig stands in for recorded kubeconfigs. It keeps the shape
+// of a real one so the provider can still decode it during replay.
+var redactedKubeConfig = base64.StdEncoding.EncodeToString([]byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: redacted
+  cluster:
+    certificate-authority-data: ` + Redacted + `
+    server: https://redacted:6443
+users:
+- name: admin
+  user:
+    client-certificate-data: ` + Redacted + `
+    client-key-data: ` + Redacted + `
+contexts:
+- name: redacted
+  context:
+    cluster: redacted
+    user: admin
+current-context: redacted
+`))
+
+// Scrub redacts secrets from a JSON body. Bodies that are not JSON are
+// returned unchanged.
+func Scrub(body string) string {
+	if body == "" {
+		return body
+	}
+
+	dec := json.NewDecoder(bytes.NewBufferString(body))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return body
+	}
+
+	out, err := json.Marshal(scrubValue(v))
+	if err != nil {
+		return body
+	}
+
+	return string(out)
+}
+
+func scrubValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			switch {
+			case k == "kube_config":
+				t[k] = redactedKubeConfig
+			case secretFields[k]:
+				if s, ok := val.(string); ok && s != "" {
+					t[k] = Redacted
+				}
+			default:
+				t[k] = scrubValue(val)
+			}
+		}
+	case []interface{}:
+		for i := range t {
+			t[i] = scrubValue(t[i])
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,12 @@
+package fakevultr
+
+import (
+	"net/http"
+)
+
+func (s *Server) registerAccountRoutes() {
+	s.handle("GET /v2/account", func(w http.ResponseWriter, r *http.Request) {
+		account := s.account
+		writeJSON(w, http.StatusOK, map[string]interface{}{"account": &account})
+	})
+}
@@ -0,0 +1,133 @@
+package fakevultr
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (s *Server) registerBlockStorageRoutes() {
+	s.handle("POST /v2/blocks", s.createBlock)
+	s.handle("GET /v2/blocks", s.listBlocks)
+	s.handle("GET /v2/blocks/{id}", s.withBlock(s.getBlock))
+	s.handle("PATCH /v2/blocks/{id}", s.withBlock(s.updateBlock))
+	s.handle("DELETE /v2/blocks/{id}", s.withBlock(s.deleteBlock))
+	s.handle("POST /v2/blocks/{id}/attach", s.withBlock(s.attachBlock))
+	s.handle("POST /v2/blocks/{id}/detach", s.withBlock(s.detachBlock))
+}
+
+func (s *Server) withBlock(h func(http.ResponseWriter, *http.Request, *govultr.BlockStorage)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		b, ok := s.blocks.get(r.PathValue("id"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Invalid block storage ID")
+			return
+		}
+		h(w, r, b)
+	}
+}
+
+func (s *Server) createBlock(w http.ResponseWriter, r *http.Request) {
+	var req govultr.BlockStorageCreate
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Region == "" || req.SizeGB == 0 {
+		writeError(w, http.StatusBadRequest, "region and size_gb are required")
+		return
+	}
+
+	blockType := req.BlockType
+	if blockType == "" {
+		blockType = "high_perf"
+	}
+
+	id := s.newID()
+	b := &govultr.BlockStorage{
+		ID:          id,
+		Cost:        float32(req.SizeGB) / 10,
+		Status:      "pending",
+		SizeGB:      req.SizeGB,
+		Region:      req.Region,
+		DateCreated: now(),
+		Label:       req.Label,
+		MountID:     req.Region + "-" + id[len(id)-6:],
+		BlockType:   blockType,
+	}
+
+	s.blocks.add(id, b)
+	s.schedule("block/"+id, func() {
+		b.Status = "active"
+	})
+
+	created := *b
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"block": &created})
+}
+
+func (s *Server) listBlocks(w http.ResponseWriter, r *http.Request) {
+	var blocks []govultr.BlockStorage
+	for _, b := range s.blocks.all() {
+		s.tick("block/" + b.ID)
+		blocks = append(blocks, *b)
+	}
+	list(w, r, "blocks", blocks)
+}
+
+func (s *Server) getBlock(w http.ResponseWriter, _ *http.Request, b *govultr.BlockStorage) {
+	s.tick("block/" + b.ID)
+
+	block := *b
+	writeJSON(w, http.StatusOK, map[string]interface{}{"block": &block})
+}
+
+func (s *Server) updateBlock(w http.ResponseWriter, r *http.Request, b *govultr.BlockStorage) {
+	var req govultr.BlockStorageUpdate
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.SizeGB != 0 {
+		if req.SizeGB < b.SizeGB {
+			writeError(w, http.StatusBadRequest, "Block storage can only be resized to a larger size")
+			return
+		}
+		b.SizeGB = req.SizeGB
+	}
+	if req.Label != "" {
+		b.Label = req.Label
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteBlock(w http.ResponseWriter, _ *http.Request, b *govultr.BlockStorage) {
+	s.blocks.remove(b.ID)
+	delete(s.lifecycles, "block/"+b.ID)
+	writeNoContent(w)
+}
+
+func (s *Server) attachBlock(w http.ResponseWriter, r *http.Request, b *govultr.BlockStorage) {
+	var req govultr.BlockStorageAttach
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if _, ok := s.instances.get(req.InstanceID); !ok {
+		writeError(w, http.StatusNotFound, "invalid instance ID")
+		return
+	}
+
+	if b.AttachedToInstance != "" {
+		writeError(w, http.StatusBadRequest, "Block storage is already attached to a server")
+		return
+	}
+
+	b.AttachedToInstance = req.InstanceID
+	writeNoContent(w)
+}
+
+func (s *Server) detachBlock(w http.ResponseWriter, _ *http.Request, b *govultr.BlockStorage) {
+	b.AttachedToInstance = ""
+	writeNoContent(w)
+}
@@ -0,0 +1,377 @@
+package fakevultr
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/vultr/govultr/v3"
+)
+
+const defaultDatabaseUser = "vultradmin"
+
+type databaseRecord struct {
+	database govultr.Database
+	users    *collection[govultr.DatabaseUser]
+	dbs      *collection[govultr.DatabaseDB]
+}
+
+func (s *Server) registerDatabaseRoutes() {
+	s.handle("POST /v2/databases", s.createDatabase)
+	s.handle("GET /v2/databases", s.listDatabases)
+	s.handle("GET /v2/databases/{id}", s.withDatabase(s.getDatabase))
+	s.handle("PUT /v2/databases/{id}", s.withDatabase(s.updateDatabase))
+	s.handle("DELETE /v2/databases/{id}", s.withDatabase(s.deleteDatabase))
+	s.handle("POST /v2/databases/{id}/read-replica", s.withDatabase(s.addDatabaseReplica))
+	s.handle("GET /v2/databases/{id}/version-upgrade", s.withDatabase(s.listDatabaseVersions))
+	s.handle("POST /v2/databases/{id}/version-upgrade", s.withDatabase(s.upgradeDatabaseVersion))
+	s.handle("POST /v2/databases/{id}/users", s.withDatabase(s.createDatabaseUser))
+	s.handle("GET /v2/databases/{id}/users", s.withDatabase(s.listDatabaseUsers))
+	s.handle("GET /v2/databases/{id}/users/{username}", s.withDatabaseUser(s.getDatabaseUser))
+	s.handle("PUT /v2/databases/{id}/users/{username}", s.withDatabaseUser(s.updateDatabaseUser))
+	s.handle("DELETE /v2/databases/{id}/users/{username}", s.withDatabaseUser(s.deleteDatabaseUser))
+	s.handle("POST /v2/databases/{id}/dbs", s.withDatabase(s.createDatabaseDB))
+	s.handle("GET /v2/databases/{id}/dbs", s.withDatabase(s.listDatabaseDBs))
+	s.handle("GET /v2/databases/{id}/dbs/{name}", s.withDatabase(s.getDatabaseDB))
+	s.handle("DELETE /v2/databases/{id}/dbs/{name}", s.withDatabase(s.deleteDatabaseDB))
+}
+
+func (s *Server) withDatabase(h func(http.ResponseWriter, *http.Request, *databaseRecord)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		db, ok := s.databases.get(r.PathValue("id"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "invalid database ID")
+			return
+		}
+		h(w, r, db)
+	}
+}
+
+func (s *Server) withDatabaseUser(h func(http.ResponseWriter, *http.Request, *databaseRecord, *govultr.DatabaseUser)) http.HandlerFunc {
+	return s.withDatabase(func(w http.ResponseWriter, r *http.Request, db *databaseRecord) {
+		user, ok := db.users.get(r.PathValue("username"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "invalid database user")
+			return
+		}
+		h(w, r, db, user)
+	})
+}
+
+func (s *Server) createDatabase(w http.ResponseWriter, r *http.Request) {
+	var req govultr.DatabaseCreateReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.DatabaseEngine == "" || req.Region == "" || req.Plan == "" || req.Label == "" {
+		writeError(w, http.StatusBadRequest, "database_engine, region, plan and label are required")
+		return
+	}
+
+	id := s.newID()
+	rec := &databaseRecord{
+		database: govultr.Database{
+			ID:                     id,
+			DateCreated:            now(),
+			Plan:                   req.Plan,
+			PlanDisk:               55,
+			PlanRAM:                4096,
+			PlanVCPUs:              2,
+			PlanReplicas:           govultr.IntToIntPtr(0),
+			Region:                 req.Region,
+			DatabaseEngine:         req.DatabaseEngine,
+			DatabaseEngineVersion:  req.DatabaseEngineVersion,
+			VPCID:                  req.VPCID,
+			Status:                 "Rebuilding",
+			Label:                  req.Label,
+			Tag:                    req.Tag,
+			DBName:                 "defaultdb",
+			Host:                   "vultr-prod-" + id[len(id)-6:] + ".vultrdb.com",
+			Port:                   "16751",
+			User:                   defaultDatabaseUser,
+			Password:               "fake-database-password",
+			MaintenanceDOW:         req.MaintenanceDOW,
+			MaintenanceTime:        req.MaintenanceTime,
+			LatestBackup:           "",
+			TrustedIPs:             slices.Clone(req.TrustedIPs),
+			MySQLSQLModes:          slices.Clone(req.MySQLSQLModes),
+			MySQLRequirePrimaryKey: req.MySQLRequirePrimaryKey,
+			MySQLSlowQueryLog:      req.MySQLSlowQueryLog,
+			MySQLLongQueryTime:     req.MySQLLongQueryTime,
+			EvictionPolicy:         req.EvictionPolicy,
+			ClusterTimeZone:        "UTC",
+		},
+		users: newCollection[govultr.DatabaseUser](),
+		dbs:   newCollection[govultr.DatabaseDB](),
+	}
+	if rec.database.MaintenanceDOW == "" {
+		rec.database.MaintenanceDOW = "sunday"
+	}
+	if rec.database.MaintenanceTime == "" {
+		rec.database.MaintenanceTime = "06:00"
+	}
+	if rec.database.TrustedIPs == nil {
+		rec.database.TrustedIPs = []string{}
+	}
+
+	rec.users.add(defaultDatabaseUser, &govultr.DatabaseUser{
+		Username: defaultDatabaseUser,
+		Password: rec.database.Password,
+	})
+	rec.dbs.add("defaultdb", &govultr.DatabaseDB{Name: "defaultdb"})
+
+	s.databases.add(id, rec)
+	s.schedule("database/"+id, func() {
+		rec.database.Status = "Running"
+		rec.database.LatestBackup = now()
+	})
+
+	created := rec.database
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"database": &created})
+}
+
+// addDatabaseReplica creates a read replica with the engine and plan of its
+// parent. Like the API, the replica starts without a tag.
+func (s *Server) addDatabaseReplica(w http.ResponseWriter, r *http.Request, parent *databaseRecord) {
+	var req govultr.DatabaseAddReplicaReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Region == "" || req.Label == "" {
+		writeError(w, http.StatusBadRequest, "region and label are required")
+		return
+	}
+
+	id := s.newID()
+	rec := &databaseRecord{
+		database: parent.database,
+		users:    newCollection[govultr.DatabaseUser](),
+		dbs:      newCollection[govultr.DatabaseDB](),
+	}
+	rec.database.ID = id
+	rec.database.DateCreated = now()
+	rec.database.Region = req.Region
+	rec.database.Label = req.Label
+	rec.database.Tag = ""
+	rec.database.Status = "Rebuilding"
+	rec.database.Host = "vultr-prod-" + id[len(id)-6:] + ".vultrdb.com"
+	rec.database.ReadReplicas = nil
+	rec.database.TrustedIPs = slices.Clone(parent.database.TrustedIPs)
+
+	s.databases.add(id, rec)
+	s.schedule("database/"+id, func() {
+		rec.database.Status = "Running"
+	})
+
+	created := rec.database
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"database": &created})
+}
+
+func (s *Server) listDatabases(w http.ResponseWriter, r *http.Request) {
+	var databases []govultr.Database
+	for _, rec := range s.databases.all() {
+		s.tick("database/" + rec.database.ID)
+		if v := r.URL.Query().Get("label"); v != "" && v != rec.database.Label {
+			continue
+		}
+		databases = append(databases, rec.database)
+	}
+	list(w, r, "databases", databases)
+}
+
+func (s *Server) getDatabase(w http.ResponseWriter, _ *http.Request, rec *databaseRecord) {
+	s.tick("database/" + rec.database.ID)
+
+	db := rec.database
+	writeJSON(w, http.StatusOK, map[string]interface{}{"database": &db})
+}
+
+func (s *Server) updateDatabase(w http.ResponseWriter, r *http.Request, rec *databaseRecord) {
+	var req govultr.DatabaseUpdateReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	db := &rec.database
+	if req.Plan != "" && req.Plan != db.Plan {
+		db.Plan = req.Plan
+		db.Status = "Rebalancing"
+		s.schedule("database/"+db.ID, func() {
+			db.Status = "Running"
+		})
+	}
+	if req.Region != "" {
+		db.Region = req.Region
+	}
+	if req.Label != "" {
+		db.Label = req.Label
+	}
+	if req.Tag != "" {
+		db.Tag = req.Tag
+	}
+	if req.VPCID != nil {
+		db.VPCID = *req.VPCID
+	}
+	if req.MaintenanceDOW != "" {
+		db.MaintenanceDOW = req.MaintenanceDOW
+	}
+	if req.MaintenanceTime != "" {
+		db.MaintenanceTime = req.MaintenanceTime
+	}
+	if req.ClusterTimeZone != "" {
+		db.ClusterTimeZone = req.ClusterTimeZone
+	}
+	if req.TrustedIPs != nil {
+		db.TrustedIPs = slices.Clone(req.TrustedIPs)
+	}
+	if req.MySQLSQLModes != nil {
+		db.MySQLSQLModes = slices.Clone(req.MySQLSQLModes)
+	}
+	if req.MySQLRequirePrimaryKey != nil {
+		db.MySQLRequirePrimaryKey = req.MySQLRequirePrimaryKey
+	}
+	if req.MySQLSlowQueryLog != nil {
+		db.MySQLSlowQueryLog = req.MySQLSlowQueryLog
+	}
+	if req.MySQLLongQueryTime != 0 {
+		db.MySQLLongQueryTime = req.MySQLLongQueryTime
+	}
+	if req.EvictionPolicy != "" {
+		db.EvictionPolicy = req.EvictionPolicy
+	}
+
+	updated := *db
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"database": &updated})
+}
+
+func (s *Server) deleteDatabase(w http.ResponseWriter, _ *http.Request, rec *databaseRecord) {
+	s.databases.remove(rec.database.ID)
+	delete(s.lifecycles, "database/"+rec.database.ID)
+	writeNoContent(w)
+}
+
+func (s *Server) listDatabaseVersions(w http.ResponseWriter, _ *http.Request, _ *databaseRecord) {
+	writeJSON(w, http.StatusOK, govultr.DatabaseAvailableVersions{AvailableVersions: []string{}})
+}
+
+func (s *Server) upgradeDatabaseVersion(w http.ResponseWriter, r *http.Request, rec *databaseRecord) {
+	var req govultr.DatabaseVersionUpgradeReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	rec.database.DatabaseEngineVersion = req.Version
+	writeJSON(w, http.StatusAccepted, map[string]string{"message": "Version upgrade started."})
+}
+
+func (s *Server) createDatabaseUser(w http.ResponseWriter, r *http.Request, rec *databaseRecord) {
+	var req govultr.DatabaseUserCreateReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Username == "" {
+		writeError(w, http.StatusBadRequest, "username is required")
+		return
+	}
+
+	if _, ok := rec.users.get(req.Username); ok {
+		writeError(w, http.StatusBadRequest, "a user with that username already exists")
+		return
+	}
+
+	user := &govultr.DatabaseUser{
+		Username:   req.Username,
+		Password:   req.Password,
+		Encryption: req.Encryption,
+		Permission: req.Permission,
+	}
+	if user.Password == "" {
+		user.Password = "fake-user-password"
+	}
+
+	rec.users.add(user.Username, user)
+
+	created := *user
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"user": &created})
+}
+
+func (s *Server) listDatabaseUsers(w http.ResponseWriter, r *http.Request, rec *databaseRecord) {
+	var users []govultr.DatabaseUser
+	for _, user := range rec.users.all() {
+		users = append(users, *user)
+	}
+	list(w, r, "users", users)
+}
+
+func (s *Server) getDatabaseUser(w http.ResponseWriter, _ *http.Request, _ *databaseRecord, user *govultr.DatabaseUser) {
+	u := *user
+	writeJSON(w, http.StatusOK, map[string]interface{}{"user": &u})
+}
+
+func (s *Server) updateDatabaseUser(w http.ResponseWriter, r *http.Request, rec *databaseRecord, user *govultr.DatabaseUser) {
+	var req govultr.DatabaseUserUpdateReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	user.Password = req.Password
+	if user.Username == defaultDatabaseUser {
+		rec.database.Password = req.Password
+	}
+
+	u := *user
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"user": &u})
+}
+
+func (s *Server) deleteDatabaseUser(w http.ResponseWriter, _ *http.Request, rec *databaseRecord, user *govultr.DatabaseUser) {
+	if user.Username == defaultDatabaseUser {
+		writeError(w, http.StatusBadRequest, "the default user cannot be deleted")
+		return
+	}
+
+	rec.users.remove(user.Username)
+	writeNoContent(w)
+}
+
+func (s *Server) createDatabaseDB(w http.ResponseWriter, r *http.Request, rec *databaseRecord) {
+	var req govultr.DatabaseDBCreateReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	db := &govultr.DatabaseDB{Name: req.Name}
+	rec.dbs.add(req.Name, db)
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"db": db})
+}
+
+func (s *Server) listDatabaseDBs(w http.ResponseWriter, r *http.Request, rec *databaseRecord) {
+	var dbs []govultr.DatabaseDB
+	for _, db := range rec.dbs.all() {
+		dbs = append(dbs, *db)
+	}
+	list(w, r, "dbs", dbs)
+}
+
+func (s *Server) getDatabaseDB(w http.ResponseWriter, r *http.Request, rec *databaseRecord) {
+	db, ok := rec.dbs.get(r.PathValue("name"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "invalid logical database")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"db": db})
+}
+
+func (s *Server) deleteDatabaseDB(w http.ResponseWriter, r *http.Request, rec *databaseRecord) {
+	if !rec.dbs.remove(r.PathValue("name")) {
+		writeError(w, http.StatusNotFound, "invalid logical database")
+		return
+	}
+	writeNoContent(w)
+}
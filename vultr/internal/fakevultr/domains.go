@@ -0,0 +1,193 @@
+package fakevultr
+
+import (
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+type domainRecord struct {
+	domain  govultr.Domain
+	records *collection[govultr.DomainRecord]
+}
+
+func (s *Server) registerDomainRoutes() {
+	s.handle("POST /v2/domains", s.createDomain)
+	s.handle("GET /v2/domains", s.listDomains)
+	s.handle("GET /v2/domains/{domain}", s.withDomain(s.getDomain))
+	s.handle("PUT /v2/domains/{domain}", s.withDomain(s.updateDomain))
+	s.handle("DELETE /v2/domains/{domain}", s.withDomain(s.deleteDomain))
+	s.handle("POST /v2/domains/{domain}/records", s.withDomain(s.createDomainRecord))
+	s.handle("GET /v2/domains/{domain}/records", s.withDomain(s.listDomainRecords))
+	s.handle("GET /v2/domains/{domain}/records/{id}", s.withDomainRecord(s.getDomainRecord))
+	s.handle("PATCH /v2/domains/{domain}/records/{id}", s.withDomainRecord(s.updateDomainRecord))
+	s.handle("DELETE /v2/domains/{domain}/records/{id}", s.withDomainRecord(s.deleteDomainRecord))
+}
+
+func (s *Server) withDomain(h func(http.ResponseWriter, *http.Request, *domainRecord)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		d, ok := s.domains.get(r.PathValue("domain"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Invalid domain.")
+			return
+		}
+		h(w, r, d)
+	}
+}
+
+func (s *Server) withDomainRecord(h func(http.ResponseWriter, *http.Request, *domainRecord, *govultr.DomainRecord)) http.HandlerFunc {
+	return s.withDomain(func(w http.ResponseWriter, r *http.Request, d *domainRecord) {
+		rec, ok := d.records.get(r.PathValue("id"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Invalid record.")
+			return
+		}
+		h(w, r, d, rec)
+	})
+}
+
+func (s *Server) createDomain(w http.ResponseWriter, r *http.Request) {
+	var req govultr.DomainReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Domain == "" {
+		writeError(w, http.StatusBadRequest, "domain is required")
+		return
+	}
+
+	if _, ok := s.domains.get(req.Domain); ok {
+		writeError(w, http.StatusBadRequest, "Domain already exists.")
+		return
+	}
+
+	dnsSec := req.DNSSec
+	if dnsSec == "" {
+		dnsSec = "disabled"
+	}
+
+	d := &domainRecord{
+		domain: govultr.Domain{
+			Domain:      req.Domain,
+			DateCreated: now(),
+			DNSSec:      dnsSec,
+		},
+		records: newCollection[govultr.DomainRecord](),
+	}
+
+	if req.IP != "" {
+		id := s.newID()
+		d.records.add(id, &govultr.DomainRecord{ID: id, Type: "A", Name: "", Data: req.IP, TTL: 300})
+	}
+
+	s.domains.add(req.Domain, d)
+
+	domain := d.domain
+	writeJSON(w, http.StatusOK, map[string]interface{}{"domain": &domain})
+}
+
+func (s *Server) listDomains(w http.ResponseWriter, r *http.Request) {
+	var domains []govultr.Domain
+	for _, d := range s.domains.all() {
+		domains = append(domains, d.domain)
+	}
+	list(w, r, "domains", domains)
+}
+
+func (s *Server) getDomain(w http.ResponseWriter, _ *http.Request, d *domainRecord) {
+	domain := d.domain
+	writeJSON(w, http.StatusOK, map[string]interface{}{"domain": &domain})
+}
+
+func (s *Server) updateDomain(w http.ResponseWriter, r *http.Request, d *domainRecord) {
+	var req struct {
+		DNSSec string `json:"dns_sec"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.DNSSec != "enabled" && req.DNSSec != "disabled" {
+		writeError(w, http.StatusBadRequest, "dns_sec must be enabled or disabled")
+		return
+	}
+
+	d.domain.DNSSec = req.DNSSec
+	writeNoContent(w)
+}
+
+func (s *Server) deleteDomain(w http.ResponseWriter, _ *http.Request, d *domainRecord) {
+	s.domains.remove(d.domain.Domain)
+	writeNoContent(w)
+}
+
+func (s *Server) createDomainRecord(w http.ResponseWriter, r *http.Request, d *domainRecord) {
+	var req govultr.DomainRecordReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Type == "" || req.Data == "" {
+		writeError(w, http.StatusBadRequest, "type and data are required")
+		return
+	}
+
+	id := s.newID()
+	rec := &govultr.DomainRecord{
+		ID:   id,
+		Type: req.Type,
+		Name: req.Name,
+		Data: req.Data,
+		TTL:  req.TTL,
+	}
+	if rec.TTL == 0 {
+		rec.TTL = 300
+	}
+	if req.Priority != nil {
+		rec.Priority = *req.Priority
+	}
+
+	d.records.add(id, rec)
+
+	created := *rec
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"record": &created})
+}
+
+func (s *Server) listDomainRecords(w http.ResponseWriter, r *http.Request, d *domainRecord) {
+	var records []govultr.DomainRecord
+	for _, rec := range d.records.all() {
+		records = append(records, *rec)
+	}
+	list(w, r, "records", records)
+}
+
+func (s *Server) getDomainRecord(w http.ResponseWriter, _ *http.Request, _ *domainRecord, rec *govultr.DomainRecord) {
+	record := *rec
+	writeJSON(w, http.StatusOK, map[string]interface{}{"record": &record})
+}
+
+func (s *Server) updateDomainRecord(w http.ResponseWriter, r *http.Request, _ *domainRecord, rec *govultr.DomainRecord) {
+	var req govultr.DomainRecordReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	rec.Name = req.Name
+	if req.Data != "" {
+		rec.Data = req.Data
+	}
+	if req.TTL != 0 {
+		rec.TTL = req.TTL
+	}
+	if req.Priority != nil {
+		rec.Priority = *req.Priority
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteDomainRecord(w http.ResponseWriter, _ *http.Request, d *domainRecord, rec *govultr.DomainRecord) {
+	d.records.remove(rec.ID)
+	writeNoContent(w)
+}
@@ -0,0 +1,158 @@
+package fakevultr
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/vultr/govultr/v3"
+)
+
+const maxFirewallRules = 50
+
+type firewallGroupRecord struct {
+	group govultr.FirewallGroup
+	rules *collection[govultr.FirewallRule]
+}
+
+func (s *Server) registerFirewallRoutes() {
+	s.handle("POST /v2/firewalls", s.createFirewallGroup)
+	s.handle("GET /v2/firewalls", s.listFirewallGroups)
+	s.handle("GET /v2/firewalls/{id}", s.withFirewallGroup(s.getFirewallGroup))
+	s.handle("PUT /v2/firewalls/{id}", s.withFirewallGroup(s.updateFirewallGroup))
+	s.handle("DELETE /v2/firewalls/{id}", s.withFirewallGroup(s.deleteFirewallGroup))
+	s.handle("POST /v2/firewalls/{id}/rules", s.withFirewallGroup(s.createFirewallRule))
+	s.handle("GET /v2/firewalls/{id}/rules", s.withFirewallGroup(s.listFirewallRules))
+	s.handle("GET /v2/firewalls/{id}/rules/{rule}", s.withFirewallRule(s.getFirewallRule))
+	s.handle("DELETE /v2/firewalls/{id}/rules/{rule}", s.withFirewallRule(s.deleteFirewallRule))
+}
+
+func (s *Server) withFirewallGroup(h func(http.ResponseWriter, *http.Request, *firewallGroupRecord)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		g, ok := s.firewallGroups.get(r.PathValue("id"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Firewall group not found.")
+			return
+		}
+		h(w, r, g)
+	}
+}
+
+func (s *Server) withFirewallRule(h func(http.ResponseWriter, *http.Request, *firewallGroupRecord, *govultr.FirewallRule)) http.HandlerFunc {
+	return s.withFirewallGroup(func(w http.ResponseWriter, r *http.Request, g *firewallGroupRecord) {
+		rule, ok := g.rules.get(r.PathValue("rule"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Firewall rule ID not found.")
+			return
+		}
+		h(w, r, g, rule)
+	})
+}
+
+func (s *Server) createFirewallGroup(w http.ResponseWriter, r *http.Request) {
+	var req govultr.FirewallGroupReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.seq++
+	id := fmt.Sprintf("%08x", s.seq)
+	g := &firewallGroupRecord{
+		group: govultr.FirewallGroup{
+			ID:           id,
+			Description:  req.Description,
+			DateCreated:  now(),
+			DateModified: now(),
+			MaxRuleCount: maxFirewallRules,
+		},
+		rules: newCollection[govultr.FirewallRule](),
+	}
+	s.firewallGroups.add(id, g)
+
+	group := g.group
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"firewall_group": &group})
+}
+
+func (s *Server) listFirewallGroups(w http.ResponseWriter, r *http.Request) {
+	var groups []govultr.FirewallGroup
+	for _, g := range s.firewallGroups.all() {
+		groups = append(groups, g.group)
+	}
+	list(w, r, "firewall_groups", groups)
+}
+
+func (s *Server) getFirewallGroup(w http.ResponseWriter, _ *http.Request, g *firewallGroupRecord) {
+	group := g.group
+	writeJSON(w, http.StatusOK, map[string]interface{}{"firewall_group": &group})
+}
+
+func (s *Server) updateFirewallGroup(w http.ResponseWriter, r *http.Request, g *firewallGroupRecord) {
+	var req govultr.FirewallGroupReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	g.group.Description = req.Description
+	g.group.DateModified = now()
+	writeNoContent(w)
+}
+
+func (s *Server) deleteFirewallGroup(w http.ResponseWriter, _ *http.Request, g *firewallGroupRecord) {
+	s.firewallGroups.remove(g.group.ID)
+	writeNoContent(w)
+}
+
+func (s *Server) createFirewallRule(w http.ResponseWriter, r *http.Request, g *firewallGroupRecord) {
+	var req govultr.FirewallRuleReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.IPType != "v4" && req.IPType != "v6" {
+		writeError(w, http.StatusBadRequest, "ip_type must be v4 or v6")
+		return
+	}
+
+	if g.group.RuleCount >= g.group.MaxRuleCount {
+		writeError(w, http.StatusBadRequest, "Firewall group has reached the maximum number of rules.")
+		return
+	}
+
+	s.firewallRuleSeq++
+	rule := &govultr.FirewallRule{
+		ID:         s.firewallRuleSeq,
+		Action:     "accept",
+		IPType:     req.IPType,
+		Protocol:   req.Protocol,
+		Port:       req.Port,
+		Subnet:     req.Subnet,
+		SubnetSize: req.SubnetSize,
+		Source:     req.Source,
+		Notes:      req.Notes,
+	}
+
+	g.rules.add(strconv.Itoa(rule.ID), rule)
+	g.group.RuleCount++
+
+	created := *rule
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"firewall_rule": &created})
+}
+
+func (s *Server) listFirewallRules(w http.ResponseWriter, r *http.Request, g *firewallGroupRecord) {
+	var rules []govultr.FirewallRule
+	for _, rule := range g.rules.all() {
+		rules = append(rules, *rule)
+	}
+	list(w, r, "firewall_rules", rules)
+}
+
+func (s *Server) getFirewallRule(w http.ResponseWriter, _ *http.Request, _ *firewallGroupRecord, rule *govultr.FirewallRule) {
+	fw := *rule
+	writeJSON(w, http.StatusOK, map[string]interface{}{"firewall_rule": &fw})
+}
+
+func (s *Server) deleteFirewallRule(w http.ResponseWriter, _ *http.Request, g *firewallGroupRecord, rule *govultr.FirewallRule) {
+	g.rules.remove(strconv.Itoa(rule.ID))
+	g.group.RuleCount--
+	writeNoContent(w)
+}
@@ -0,0 +1,453 @@
+package fakevultr
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// instanceRecord is an instance along with the sub-resources the API
+// exposes on separate endpoints
+type instanceRecord struct {
+	instance govultr.Instance
+	backup   govultr.BackupSchedule
+	iso      govultr.Iso
+	userData string
+	vpcs     []string
+	vpc2s    []string
+	rebuilds int
+}
+
+func (s *Server) registerInstanceRoutes() {
+	s.handle("POST /v2/instances", s.createInstance)
+	s.handle("GET /v2/instances", s.listInstances)
+	s.handle("GET /v2/instances/{id}", s.withInstance(s.getInstance))
+	s.handle("PATCH /v2/instances/{id}", s.withInstance(s.updateInstance))
+	s.handle("DELETE /v2/instances/{id}", s.withInstance(s.deleteInstance))
+	s.handle("POST /v2/instances/{id}/start", s.withInstance(s.setPowerStatus("running")))
+	s.handle("POST /v2/instances/{id}/halt", s.withInstance(s.setPowerStatus("stopped")))
+	s.handle("POST /v2/instances/{id}/reboot", s.withInstance(s.setPowerStatus("running")))
+	s.handle("POST /v2/instances/{id}/reinstall", s.withInstance(s.reinstallInstance))
+	s.handle("POST /v2/instances/{id}/restore", s.withInstance(s.restoreInstance))
+	s.handle("GET /v2/instances/{id}/backup-schedule", s.withInstance(s.getBackupSchedule))
+	s.handle("POST /v2/instances/{id}/backup-schedule", s.withInstance(s.setBackupSchedule))
+	s.handle("GET /v2/instances/{id}/vpcs", s.withInstance(s.listInstanceVPCs))
+	s.handle("POST /v2/instances/{id}/vpcs/attach", s.withInstance(s.attachInstanceVPC))
+	s.handle("POST /v2/instances/{id}/vpcs/detach", s.withInstance(s.detachInstanceVPC))
+	s.handle("GET /v2/instances/{id}/vpc2", s.withInstance(s.listInstanceVPC2s))
+	s.handle("POST /v2/instances/{id}/vpc2/attach", s.withInstance(s.attachInstanceVPC2))
+	s.handle("POST /v2/instances/{id}/vpc2/detach", s.withInstance(s.detachInstanceVPC2))
+	s.handle("GET /v2/instances/{id}/iso", s.withInstance(s.getInstanceISO))
+	s.handle("POST /v2/instances/{id}/iso/attach", s.withInstance(s.attachInstanceISO))
+	s.handle("POST /v2/instances/{id}/iso/detach", s.withInstance(s.detachInstanceISO))
+	s.handle("GET /v2/instances/{id}/user-data", s.withInstance(s.getInstanceUserData))
+}
+
+// withInstance resolves the {id} path value, responding with the API's
+// not found error when the instance does not exist.
+func (s *Server) withInstance(h func(http.ResponseWriter, *http.Request, *instanceRecord)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rec, ok := s.instances.get(r.PathValue("id"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "invalid instance ID")
+			return
+		}
+		h(w, r, rec)
+	}
+}
+
+func (s *Server) createInstance(w http.ResponseWriter, r *http.Request) {
+	var req govultr.InstanceCreateReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Region == "" || req.Plan == "" {
+		writeError(w, http.StatusBadRequest, "region and plan are required")
+		return
+	}
+
+	id := s.newID()
+	rec := &instanceRecord{
+		instance: govultr.Instance{
+			ID:               id,
+			Os:               "Fake OS",
+			RAM:              1024,
+			Disk:             25,
+			Plan:             req.Plan,
+			MainIP:           s.newIP(),
+			VCPUCount:        1,
+			Region:           req.Region,
+			DefaultPassword:  "fake-password",
+			DateCreated:      now(),
+			Status:           "pending",
+			AllowedBandwidth: 1000,
+			NetmaskV4:        "255.255.255.0",
+			GatewayV4:        "192.0.2.1",
+			PowerStatus:      "stopped",
+			ServerStatus:     "none",
+			Label:            req.Label,
+			OsID:             req.OsID,
+			AppID:            req.AppID,
+			ImageID:          req.ImageID,
+			FirewallGroupID:  req.FirewallGroupID,
+			Features:         []string{},
+			Hostname:         req.Hostname,
+			Tags:             []string{},
+			UserScheme:       req.UserScheme,
+		},
+		backup:   govultr.BackupSchedule{Enabled: govultr.BoolToBoolPtr(req.Backups == "enabled")},
+		iso:      govultr.Iso{State: "ready"},
+		userData: req.UserData,
+		vpcs:     slices.Clone(req.AttachVPC),
+		vpc2s:    slices.Clone(req.AttachVPC2),
+	}
+
+	if req.Tags != nil {
+		rec.instance.Tags = slices.Clone(req.Tags)
+	}
+	if req.Hostname == "" {
+		rec.instance.Hostname = req.Label
+	}
+	if req.UserScheme == "" {
+		rec.instance.UserScheme = "root"
+	}
+	if req.EnableIPv6 != nil && *req.EnableIPv6 {
+		rec.instance.Features = append(rec.instance.Features, "ipv6")
+		rec.instance.V6MainIP = "2001:db8::1"
+		rec.instance.V6Network = "2001:db8::"
+		rec.instance.V6NetworkSize = 64
+	}
+	if req.ISOID != "" {
+		rec.iso = govultr.Iso{State: "isomounted", IsoID: req.ISOID}
+	}
+
+	s.instances.add(id, rec)
+	s.schedule("instance/"+id, func() {
+		rec.instance.Status = "active"
+		rec.instance.PowerStatus = "running"
+		rec.instance.ServerStatus = "ok"
+	})
+
+	created := rec.instance
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"instance": &created})
+}
+
+func (s *Server) listInstances(w http.ResponseWriter, r *http.Request) {
+	var instances []govultr.Instance
+	for _, rec := range s.instances.all() {
+		s.tick("instance/" + rec.instance.ID)
+		if !matchesInstanceQuery(r, &rec.instance) {
+			continue
+		}
+		instance := rec.instance
+		instance.DefaultPassword = ""
+		instances = append(instances, instance)
+	}
+
+	list(w, r, "instances", instances)
+}
+
+func matchesInstanceQuery(r *http.Request, i *govultr.Instance) bool {
+	q := r.URL.Query()
+	if v := q.Get("label"); v != "" && v != i.Label {
+		return false
+	}
+	if v := q.Get("main_ip"); v != "" && v != i.MainIP {
+		return false
+	}
+	if v := q.Get("region"); v != "" && v != i.Region {
+		return false
+	}
+	if v := q.Get("tag"); v != "" && !slices.Contains(i.Tags, v) {
+		return false
+	}
+	return true
+}
+
+func (s *Server) getInstance(w http.ResponseWriter, _ *http.Request, rec *instanceRecord) {
+	s.tick("instance/" + rec.instance.ID)
+
+	instance := rec.instance
+	instance.DefaultPassword = ""
+	writeJSON(w, http.StatusOK, map[string]interface{}{"instance": &instance})
+}
+
+func (s *Server) updateInstance(w http.ResponseWriter, r *http.Request, rec *instanceRecord) {
+	var req govultr.InstanceUpdateReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	i := &rec.instance
+	if req.Label != "" {
+		i.Label = req.Label
+	}
+	if req.Plan != "" {
+		// Like the API, a resize boots a stopped instance
+		i.Plan = req.Plan
+		i.PowerStatus = "running"
+	}
+	if req.Tags != nil {
+		i.Tags = slices.Clone(req.Tags)
+	}
+	if req.FirewallGroupID != "" {
+		i.FirewallGroupID = req.FirewallGroupID
+	}
+	if req.UserData != "" {
+		rec.userData = req.UserData
+	}
+	if req.UserScheme != "" {
+		i.UserScheme = req.UserScheme
+	}
+	if req.Backups != "" {
+		rec.backup.Enabled = govultr.BoolToBoolPtr(req.Backups == "enabled")
+	}
+	if req.OsID != 0 {
+		i.OsID = req.OsID
+	}
+	if req.ImageID != "" {
+		i.ImageID = req.ImageID
+	}
+
+	rec.vpcs = attachDetach(rec.vpcs, req.AttachVPC, req.DetachVPC)
+	rec.vpc2s = attachDetach(rec.vpc2s, req.AttachVPC2, req.DetachVPC2)
+
+	// Like the API, a new OS or image is installed straight away
+	if req.OsID != 0 || req.ImageID != "" {
+		s.reinstall(rec, "pending")
+	}
+
+	instance := rec.instance
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"instance": &instance})
+}
+
+func attachDetach(current, attach, detach []string) []string {
+	for _, id := range attach {
+		if !slices.Contains(current, id) {
+			current = append(current, id)
+		}
+	}
+	return slices.DeleteFunc(current, func(id string) bool {
+		return slices.Contains(detach, id)
+	})
+}
+
+func (s *Server) deleteInstance(w http.ResponseWriter, _ *http.Request, rec *instanceRecord) {
+	s.instances.remove(rec.instance.ID)
+	delete(s.lifecycles, "instance/"+rec.instance.ID)
+	writeNoContent(w)
+}
+
+func (s *Server) setPowerStatus(status string) func(http.ResponseWriter, *http.Request, *instanceRecord) {
+	return func(w http.ResponseWriter, _ *http.Request, rec *instanceRecord) {
+		rec.instance.PowerStatus = status
+		writeNoContent(w)
+	}
+}
+
+func (s *Server) reinstallInstance(w http.ResponseWriter, r *http.Request, rec *instanceRecord) {
+	var req govultr.ReinstallReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Hostname != "" {
+		rec.instance.Hostname = req.Hostname
+	}
+
+	s.reinstall(rec, "pending")
+
+	instance := rec.instance
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"instance": &instance})
+}
+
+func (s *Server) restoreInstance(w http.ResponseWriter, r *http.Request, rec *instanceRecord) {
+	var req govultr.RestoreReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.BackupID == "" && req.SnapshotID == "" {
+		writeError(w, http.StatusBadRequest, "backup_id or snapshot_id is required")
+		return
+	}
+
+	// Unlike a reinstall, a restore leaves the instance active and only
+	// reports it in the server status
+	s.reinstall(rec, "active")
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{
+		"status": map[string]string{"restore_type": "backup_id", "restore_id": req.BackupID + req.SnapshotID},
+	})
+}
+
+// Rebuilds returns how many times an instance has been reinstalled, restored
+// or had its OS changed since it was created
+func (s *Server) Rebuilds(instanceID string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.instances.get(instanceID)
+	if !ok {
+		return 0
+	}
+	return rec.rebuilds
+}
+
+// reinstall walks the instance back through installing to active, reporting
+// status while it rebuilds. As with the API, the backup schedule is reset.
+func (s *Server) reinstall(rec *instanceRecord, status string) {
+	rec.rebuilds++
+	rec.backup = govultr.BackupSchedule{Enabled: rec.backup.Enabled}
+
+	key := "instance/" + rec.instance.ID
+	settle := func() {
+		rec.instance.Status = "active"
+		rec.instance.PowerStatus = "running"
+		rec.instance.ServerStatus = "ok"
+	}
+	start := func() {
+		rec.instance.Status = status
+		rec.instance.ServerStatus = "installingbooting"
+		if s.rebuildLag > 0 {
+			s.lifecycles[key] = &lifecycle{remaining: s.rebuildLag, settle: settle}
+			return
+		}
+		s.schedule(key, settle)
+	}
+
+	if s.rebuildLag > 0 {
+		s.lifecycles[key] = &lifecycle{remaining: s.rebuildLag, settle: start}
+		return
+	}
+	start()
+}
+
+func (s *Server) getBackupSchedule(w http.ResponseWriter, _ *http.Request, rec *instanceRecord) {
+	backup := rec.backup
+	writeJSON(w, http.StatusOK, map[string]interface{}{"backup_schedule": &backup})
+}
+
+func (s *Server) setBackupSchedule(w http.ResponseWriter, r *http.Request, rec *instanceRecord) {
+	var req govultr.BackupScheduleReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	rec.backup = govultr.BackupSchedule{
+		Enabled:             govultr.BoolToBoolPtr(true),
+		Type:                req.Type,
+		Dom:                 req.Dom,
+		NextScheduleTimeUTC: "2030-01-01 00:00:00",
+	}
+	if req.Hour != nil {
+		rec.backup.Hour = *req.Hour
+	}
+	if req.Dow != nil {
+		rec.backup.Dow = *req.Dow
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) listInstanceVPCs(w http.ResponseWriter, r *http.Request, rec *instanceRecord) {
+	vpcs := make([]govultr.VPCInfo, 0, len(rec.vpcs))
+	for _, id := range rec.vpcs {
+		vpcs = append(vpcs, govultr.VPCInfo{ID: id, MacAddress: "5a:00:00:00:00:01", IPAddress: "10.0.0.3"})
+	}
+	list(w, r, "vpcs", vpcs)
+}
+
+func (s *Server) attachInstanceVPC(w http.ResponseWriter, r *http.Request, rec *instanceRecord) {
+	var req struct {
+		VPCID string `json:"vpc_id"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if _, ok := s.vpcs.get(req.VPCID); !ok {
+		writeError(w, http.StatusNotFound, "Invalid VPC ID")
+		return
+	}
+
+	rec.vpcs = attachDetach(rec.vpcs, []string{req.VPCID}, nil)
+	writeNoContent(w)
+}
+
+func (s *Server) detachInstanceVPC(w http.ResponseWriter, r *http.Request, rec *instanceRecord) {
+	var req struct {
+		VPCID string `json:"vpc_id"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	rec.vpcs = attachDetach(rec.vpcs, nil, []string{req.VPCID})
+	writeNoContent(w)
+}
+
+func (s *Server) listInstanceVPC2s(w http.ResponseWriter, r *http.Request, rec *instanceRecord) {
+	vpcs := make([]govultr.VPC2Info, 0, len(rec.vpc2s))
+	for _, id := range rec.vpc2s {
+		vpcs = append(vpcs, govultr.VPC2Info{ID: id, MacAddress: "5a:00:00:00:00:02", IPAddress: "10.1.0.3"})
+	}
+	list(w, r, "vpcs", vpcs)
+}
+
+func (s *Server) attachInstanceVPC2(w http.ResponseWriter, r *http.Request, rec *instanceRecord) {
+	var req govultr.AttachVPC2Req
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if _, ok := s.vpc2s.get(req.VPCID); !ok {
+		writeError(w, http.StatusNotFound, "Invalid VPC 2.0 ID")
+		return
+	}
+
+	rec.vpc2s = attachDetach(rec.vpc2s, []string{req.VPCID}, nil)
+	writeNoContent(w)
+}
+
+func (s *Server) detachInstanceVPC2(w http.ResponseWriter, r *http.Request, rec *instanceRecord) {
+	var req struct {
+		VPCID string `json:"vpc_id"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	rec.vpc2s = attachDetach(rec.vpc2s, nil, []string{req.VPCID})
+	writeNoContent(w)
+}
+
+func (s *Server) getInstanceISO(w http.ResponseWriter, _ *http.Request, rec *instanceRecord) {
+	iso := rec.iso
+	writeJSON(w, http.StatusOK, map[string]interface{}{"iso_status": &iso})
+}
+
+func (s *Server) attachInstanceISO(w http.ResponseWriter, r *http.Request, rec *instanceRecord) {
+	var req struct {
+		ISOID string `json:"iso_id"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	// Like the API, changing the ISO reboots the instance
+	rec.iso = govultr.Iso{State: "isomounted", IsoID: req.ISOID}
+	rec.instance.PowerStatus = "running"
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"iso_status": &rec.iso})
+}
+
+func (s *Server) detachInstanceISO(w http.ResponseWriter, _ *http.Request, rec *instanceRecord) {
+	rec.iso = govultr.Iso{State: "ready"}
+	rec.instance.PowerStatus = "running"
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"iso_status": &rec.iso})
+}
+
+func (s *Server) getInstanceUserData(w http.ResponseWriter, _ *http.Request, rec *instanceRecord) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"user_data": govultr.UserData{Data: rec.userData}})
+}
@@ -0,0 +1,306 @@
+package fakevultr
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/vultr/govultr/v3"
+)
+
+var kubernetesVersions = []string{"v1.31.2+1", "v1.30.6+1", "v1.29.10+1"}
+
+const kubeConfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- name: %[1]s
+  cluster:
+    certificate-authority-data: %[2]s
+    server: https://%[3]s:6443
+users:
+- name: admin
+  user:
+    client-certificate-data: %[4]s
+    client-key-data: %[5]s
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s
+    user: admin
+current-context: %[1]s
+`
+
+func (s *Server) registerKubernetesRoutes() {
+	s.handle("GET /v2/kubernetes/versions", s.listKubernetesVersions)
+	s.handle("POST /v2/kubernetes/clusters", s.createCluster)
+	s.handle("GET /v2/kubernetes/clusters", s.listClusters)
+	s.handle("GET /v2/kubernetes/clusters/{id}", s.withCluster(s.getCluster))
+	s.handle("PUT /v2/kubernetes/clusters/{id}", s.withCluster(s.updateCluster))
+	s.handle("DELETE /v2/kubernetes/clusters/{id}", s.withCluster(s.deleteCluster))
+	s.handle("GET /v2/kubernetes/clusters/{id}/config", s.withCluster(s.getKubeConfig))
+	s.handle("POST /v2/kubernetes/clusters/{id}/upgrades", s.withCluster(s.upgradeCluster))
+	s.handle("POST /v2/kubernetes/clusters/{id}/node-pools", s.withCluster(s.createNodePool))
+	s.handle("GET /v2/kubernetes/clusters/{id}/node-pools", s.withCluster(s.listNodePools))
+	s.handle("GET /v2/kubernetes/clusters/{id}/node-pools/{pool}", s.withNodePool(s.getNodePool))
+	s.handle("PATCH /v2/kubernetes/clusters/{id}/node-pools/{pool}", s.withNodePool(s.updateNodePool))
+	s.handle("DELETE /v2/kubernetes/clusters/{id}/node-pools/{pool}", s.withNodePool(s.deleteNodePool))
+}
+
+func (s *Server) withCluster(h func(http.ResponseWriter, *http.Request, *govultr.Cluster)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c, ok := s.clusters.get(r.PathValue("id"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Invalid resource ID")
+			return
+		}
+		h(w, r, c)
+	}
+}
+
+func (s *Server) withNodePool(h func(http.ResponseWriter, *http.Request, *govultr.Cluster, *govultr.NodePool)) http.HandlerFunc {
+	return s.withCluster(func(w http.ResponseWriter, r *http.Request, c *govultr.Cluster) {
+		for i := range c.NodePools {
+			if c.NodePools[i].ID == r.PathValue("pool") {
+				h(w, r, c, &c.NodePools[i])
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "Invalid NodePool ID")
+	})
+}
+
+func (s *Server) listKubernetesVersions(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"versions": kubernetesVersions})
+}
+
+func (s *Server) createCluster(w http.ResponseWriter, r *http.Request) {
+	var req govultr.ClusterReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Region == "" || req.Version == "" {
+		writeError(w, http.StatusBadRequest, "region and version are required")
+		return
+	}
+
+	id := s.newID()
+	c := &govultr.Cluster{
+		ID:              id,
+		Label:           req.Label,
+		DateCreated:     now(),
+		ClusterSubnet:   "10.244.0.0/16",
+		ServiceSubnet:   "10.96.0.0/12",
+		IP:              s.newIP(),
+		Endpoint:        id + ".vultr-k8s.com",
+		Version:         req.Version,
+		Region:          req.Region,
+		Status:          "pending",
+		HAControlPlanes: req.HAControlPlanes,
+		NodePools:       []govultr.NodePool{},
+	}
+	if req.EnableFirewall {
+		c.FirewallGroupID = fmt.Sprintf("%08x", s.seq)
+	}
+
+	for i := range req.NodePools {
+		c.NodePools = append(c.NodePools, s.newNodePool(&req.NodePools[i]))
+	}
+
+	s.clusters.add(id, c)
+	s.schedule("cluster/"+id, func() {
+		c.Status = "active"
+		for i := range c.NodePools {
+			settleNodePool(&c.NodePools[i])
+		}
+	})
+
+	created := *c
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"vke_cluster": &created})
+}
+
+func (s *Server) newNodePool(req *govultr.NodePoolReq) govultr.NodePool {
+	np := govultr.NodePool{
+		ID:           s.newID(),
+		DateCreated:  now(),
+		DateUpdated:  now(),
+		Label:        req.Label,
+		Plan:         req.Plan,
+		Status:       "pending",
+		NodeQuantity: req.NodeQuantity,
+		MinNodes:     req.MinNodes,
+		MaxNodes:     req.MaxNodes,
+		Tag:          req.Tag,
+		Labels:       req.Labels,
+	}
+	if req.AutoScaler != nil {
+		np.AutoScaler = *req.AutoScaler
+	}
+	s.resizeNodePool(&np)
+	return np
+}
+
+// resizeNodePool adds or removes nodes to match the pool's node_quantity
+func (s *Server) resizeNodePool(np *govultr.NodePool) {
+	for len(np.Nodes) < np.NodeQuantity {
+		np.Nodes = append(np.Nodes, govultr.Node{
+			ID:          s.newID(),
+			DateCreated: now(),
+			Label:       fmt.Sprintf("%s-%d", np.Label, len(np.Nodes)),
+			Status:      "pending",
+		})
+	}
+	np.Nodes = np.Nodes[:np.NodeQuantity]
+}
+
+func settleNodePool(np *govultr.NodePool) {
+	np.Status = "active"
+	for i := range np.Nodes {
+		np.Nodes[i].Status = "active"
+	}
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, r *http.Request) {
+	var clusters []govultr.Cluster
+	for _, c := range s.clusters.all() {
+		s.tick("cluster/" + c.ID)
+		clusters = append(clusters, *c)
+	}
+	list(w, r, "vke_clusters", clusters)
+}
+
+func (s *Server) getCluster(w http.ResponseWriter, _ *http.Request, c *govultr.Cluster) {
+	s.tick("cluster/" + c.ID)
+	for i := range c.NodePools {
+		s.tick("nodepool/" + c.NodePools[i].ID)
+	}
+
+	cluster := *c
+	writeJSON(w, http.StatusOK, map[string]interface{}{"vke_cluster": &cluster})
+}
+
+func (s *Server) updateCluster(w http.ResponseWriter, r *http.Request, c *govultr.Cluster) {
+	var req govultr.ClusterReqUpdate
+	if !decode(w, r, &req) {
+		return
+	}
+
+	c.Label = req.Label
+	writeNoContent(w)
+}
+
+func (s *Server) deleteCluster(w http.ResponseWriter, _ *http.Request, c *govultr.Cluster) {
+	s.clusters.remove(c.ID)
+	delete(s.lifecycles, "cluster/"+c.ID)
+	writeNoContent(w)
+}
+
+func (s *Server) getKubeConfig(w http.ResponseWriter, _ *http.Request, c *govultr.Cluster) {
+	encode := func(v string) string {
+		return base64.StdEncoding.EncodeToString([]byte(v))
+	}
+
+	kc := fmt.Sprintf(kubeConfigTemplate,
+		"vke-"+c.ID,
+		encode("fake-ca-"+c.ID),
+		c.IP,
+		encode("fake-cert-"+c.ID),
+		encode("fake-key-"+c.ID),
+	)
+
+	writeJSON(w, http.StatusOK, govultr.KubeConfig{KubeConfig: encode(kc)})
+}
+
+func (s *Server) upgradeCluster(w http.ResponseWriter, r *http.Request, c *govultr.Cluster) {
+	var req govultr.ClusterUpgradeReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	c.Version = req.UpgradeVersion
+	writeNoContent(w)
+}
+
+func (s *Server) createNodePool(w http.ResponseWriter, r *http.Request, c *govultr.Cluster) {
+	var req govultr.NodePoolReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Plan == "" || req.NodeQuantity < 1 {
+		writeError(w, http.StatusBadRequest, "plan and node_quantity are required")
+		return
+	}
+
+	c.NodePools = append(c.NodePools, s.newNodePool(&req))
+	np := &c.NodePools[len(c.NodePools)-1]
+	id := np.ID
+	s.schedule("nodepool/"+id, func() {
+		for i := range c.NodePools {
+			if c.NodePools[i].ID == id {
+				settleNodePool(&c.NodePools[i])
+			}
+		}
+	})
+
+	created := *np
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"node_pool": &created})
+}
+
+func (s *Server) listNodePools(w http.ResponseWriter, r *http.Request, c *govultr.Cluster) {
+	for i := range c.NodePools {
+		s.tick("nodepool/" + c.NodePools[i].ID)
+	}
+	list(w, r, "node_pools", c.NodePools)
+}
+
+func (s *Server) getNodePool(w http.ResponseWriter, _ *http.Request, _ *govultr.Cluster, np *govultr.NodePool) {
+	s.tick("nodepool/" + np.ID)
+
+	pool := *np
+	writeJSON(w, http.StatusOK, map[string]interface{}{"node_pool": &pool})
+}
+
+func (s *Server) updateNodePool(w http.ResponseWriter, r *http.Request, _ *govultr.Cluster, np *govultr.NodePool) {
+	var req govultr.NodePoolReqUpdate
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.NodeQuantity != 0 {
+		np.NodeQuantity = req.NodeQuantity
+		s.resizeNodePool(np)
+		settleNodePool(np)
+	}
+	if req.Tag != nil {
+		np.Tag = *req.Tag
+	}
+	if req.MinNodes != 0 {
+		np.MinNodes = req.MinNodes
+	}
+	if req.MaxNodes != 0 {
+		np.MaxNodes = req.MaxNodes
+	}
+	if req.AutoScaler != nil {
+		np.AutoScaler = *req.AutoScaler
+	}
+	if req.Labels != nil {
+		np.Labels = req.Labels
+	}
+	np.DateUpdated = now()
+
+	pool := *np
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"node_pool": &pool})
+}
+
+func (s *Server) deleteNodePool(w http.ResponseWriter, _ *http.Request, c *govultr.Cluster, np *govultr.NodePool) {
+	id := np.ID
+	for i := range c.NodePools {
+		if c.NodePools[i].ID == id {
+			c.NodePools = append(c.NodePools[:i], c.NodePools[i+1:]...)
+			break
+		}
+	}
+	delete(s.lifecycles, "nodepool/"+id)
+	writeNoContent(w)
+}
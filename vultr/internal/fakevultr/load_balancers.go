@@ -0,0 +1,191 @@
+package fakevultr
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (s *Server) registerLoadBalancerRoutes() {
+	s.handle("POST /v2/load-balancers", s.createLoadBalancer)
+	s.handle("GET /v2/load-balancers", s.listLoadBalancers)
+	s.handle("GET /v2/load-balancers/{id}", s.withLoadBalancer(s.getLoadBalancer))
+	s.handle("PATCH /v2/load-balancers/{id}", s.withLoadBalancer(s.updateLoadBalancer))
+	s.handle("DELETE /v2/load-balancers/{id}", s.withLoadBalancer(s.deleteLoadBalancer))
+	s.handle("GET /v2/load-balancers/{id}/forwarding-rules", s.withLoadBalancer(s.listForwardingRules))
+	s.handle("GET /v2/load-balancers/{id}/firewall-rules", s.withLoadBalancer(s.listLBFirewallRules))
+}
+
+func (s *Server) withLoadBalancer(h func(http.ResponseWriter, *http.Request, *govultr.LoadBalancer)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		lb, ok := s.loadBalancers.get(r.PathValue("id"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Invalid load balancer ID")
+			return
+		}
+		h(w, r, lb)
+	}
+}
+
+func (s *Server) createLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	var req govultr.LoadBalancerReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Region == "" {
+		writeError(w, http.StatusBadRequest, "region is required")
+		return
+	}
+
+	id := s.newID()
+	lb := &govultr.LoadBalancer{
+		ID:            id,
+		DateCreated:   now(),
+		Region:        req.Region,
+		Label:         req.Label,
+		Status:        "pending",
+		IPV4:          s.newIP(),
+		Instances:     slices.Clone(req.Instances),
+		Nodes:         max(req.Nodes, 1),
+		HealthCheck:   req.HealthCheck,
+		SSLInfo:       govultr.BoolToBoolPtr(req.SSL != nil),
+		AutoSSL:       req.AutoSSL,
+		HTTP2:         req.HTTP2,
+		HTTP3:         req.HTTP3,
+		FirewallRules: s.withRuleIDs(req.FirewallRules),
+		GlobalRegions: req.GlobalRegions,
+		GenericInfo: &govultr.GenericInfo{
+			BalancingAlgorithm: req.BalancingAlgorithm,
+			Timeout:            req.Timeout,
+			SSLRedirect:        req.SSLRedirect,
+			StickySessions:     req.StickySessions,
+			ProxyProtocol:      req.ProxyProtocol,
+		},
+	}
+	if req.VPC != nil {
+		lb.GenericInfo.VPC = *req.VPC
+	}
+	if lb.GenericInfo.BalancingAlgorithm == "" {
+		lb.GenericInfo.BalancingAlgorithm = "roundrobin"
+	}
+	if lb.GenericInfo.Timeout == 0 {
+		lb.GenericInfo.Timeout = 600
+	}
+	if lb.GenericInfo.StickySessions == nil {
+		lb.GenericInfo.StickySessions = &govultr.StickySessions{}
+	}
+
+	for _, rule := range req.ForwardingRules {
+		rule.RuleID = s.newID()
+		lb.ForwardingRules = append(lb.ForwardingRules, rule)
+	}
+
+	s.loadBalancers.add(id, lb)
+	s.schedule("lb/"+id, func() {
+		lb.Status = "active"
+	})
+
+	created := *lb
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"load_balancer": &created})
+}
+
+func (s *Server) withRuleIDs(rules []govultr.LBFirewallRule) []govultr.LBFirewallRule {
+	var out []govultr.LBFirewallRule
+	for _, rule := range rules {
+		rule.RuleID = s.newID()
+		out = append(out, rule)
+	}
+	return out
+}
+
+func (s *Server) listLoadBalancers(w http.ResponseWriter, r *http.Request) {
+	var lbs []govultr.LoadBalancer
+	for _, lb := range s.loadBalancers.all() {
+		s.tick("lb/" + lb.ID)
+		lbs = append(lbs, *lb)
+	}
+	list(w, r, "load_balancers", lbs)
+}
+
+func (s *Server) getLoadBalancer(w http.ResponseWriter, _ *http.Request, lb *govultr.LoadBalancer) {
+	s.tick("lb/" + lb.ID)
+
+	l := *lb
+	writeJSON(w, http.StatusOK, map[string]interface{}{"load_balancer": &l})
+}
+
+func (s *Server) updateLoadBalancer(w http.ResponseWriter, r *http.Request, lb *govultr.LoadBalancer) {
+	var req govultr.LoadBalancerReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Label != "" {
+		lb.Label = req.Label
+	}
+	if req.Instances != nil {
+		lb.Instances = slices.Clone(req.Instances)
+	}
+	if req.Nodes != 0 {
+		lb.Nodes = req.Nodes
+	}
+	if req.HealthCheck != nil {
+		lb.HealthCheck = req.HealthCheck
+	}
+	if req.BalancingAlgorithm != "" {
+		lb.GenericInfo.BalancingAlgorithm = req.BalancingAlgorithm
+	}
+	if req.SSLRedirect != nil {
+		lb.GenericInfo.SSLRedirect = req.SSLRedirect
+	}
+	if req.ProxyProtocol != nil {
+		lb.GenericInfo.ProxyProtocol = req.ProxyProtocol
+	}
+	if req.StickySessions != nil {
+		lb.GenericInfo.StickySessions = req.StickySessions
+	}
+	if req.Timeout != 0 {
+		lb.GenericInfo.Timeout = req.Timeout
+	}
+	if req.HTTP2 != nil {
+		lb.HTTP2 = req.HTTP2
+	}
+	if req.HTTP3 != nil {
+		lb.HTTP3 = req.HTTP3
+	}
+	if req.ForwardingRules != nil {
+		lb.ForwardingRules = nil
+		for _, rule := range req.ForwardingRules {
+			rule.RuleID = s.newID()
+			lb.ForwardingRules = append(lb.ForwardingRules, rule)
+		}
+	}
+	if req.FirewallRules != nil {
+		lb.FirewallRules = s.withRuleIDs(req.FirewallRules)
+	}
+	if req.VPC != nil {
+		lb.GenericInfo.VPC = *req.VPC
+	}
+
+	writeNoContent(w)
+}
+
+func (s *Server) deleteLoadBalancer(w http.ResponseWriter, _ *http.Request, lb *govultr.LoadBalancer) {
+	if lb.Status != "active" {
+		writeError(w, http.StatusBadRequest, "Load balancer is not ready.")
+		return
+	}
+
+	s.loadBalancers.remove(lb.ID)
+	writeNoContent(w)
+}
+
+func (s *Server) listForwardingRules(w http.ResponseWriter, r *http.Request, lb *govultr.LoadBalancer) {
+	list(w, r, "forwarding_rules", lb.ForwardingRules)
+}
+
+func (s *Server) listLBFirewallRules(w http.ResponseWriter, r *http.Request, lb *govultr.LoadBalancer) {
+	list(w, r, "firewall_rules", lb.FirewallRules)
+}
@@ -0,0 +1,254 @@
+// Package fakevultr implements an in-memory stand-in for the subset of the
+// Vultr v2 API that the provider talks to through govultr. It keeps state
+// between calls, paginates list responses with meta.links.next cursors and
+// moves newly created objects through their transitional statuses so that
+// resource CRUD and wait logic can be exercised without a real account.
+package fakevultr
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vultr/govultr/v3"
+)
+
+const (
+	defaultPendingReads = 1
+	defaultPerPage      = 100
+	maxPerPage          = 500
+)
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithPendingReads sets how many reads a newly created object reports its
+// transitional status (pending, installing, Rebuilding...) before settling.
+func WithPendingReads(n int) Option {
+	return func(s *Server) {
+		s.pendingReads = n
+	}
+}
+
+// WithRebuildLag sets how many reads a reinstalled or restored instance
+// keeps reporting its previous status before the rebuild shows up, as the
+// API does for a while after accepting one. The rebuild then lasts as many
+// reads.
+func WithRebuildLag(n int) Option {
+	return func(s *Server) {
+		s.rebuildLag = n
+	}
+}
+
+// WithAccountACLs sets the ACLs reported by the account endpoint
+func WithAccountACLs(acls ...string) Option {
+	return func(s *Server) {
+		s.account.ACL = acls
+	}
+}
+
+// Server is an in-memory Vultr API
+type Server struct {
+	// APIKey is the bearer token required on every request. An empty key
+	// disables authentication.
+	APIKey string
+
+	mu           sync.Mutex
+	httpServer   *httptest.Server
+	mux          *http.ServeMux
+	seq          int
+	pendingReads int
+	rebuildLag   int
+	lifecycles   map[string]*lifecycle
+
+	account         govultr.Account
+	instances       *collection[instanceRecord]
+	blocks          *collection[govultr.BlockStorage]
+	domains         *collection[domainRecord]
+	firewallGroups  *collection[firewallGroupRecord]
+	vpcs            *collection[govultr.VPC]
+	vpc2s           *collection[govultr.VPC2]
+	loadBalancers   *collection[govultr.LoadBalancer]
+	databases       *collection[databaseRecord]
+	clusters        *collection[govultr.Cluster]
+	firewallRuleSeq int
+}
+
+// lifecycle tracks an object that is still in a transitional status. Once it
+// has been read remaining times, settle is called to move it to its final
+// status.
+type lifecycle struct {
+	remaining int
+	settle    func()
+}
+
+// New starts a fake API server that requires apiKey as a bearer token
+func New(apiKey string, opts ...Option) *Server {
+	s := &Server{
+		APIKey:       apiKey,
+		mux:          http.NewServeMux(),
+		pendingReads: defaultPendingReads,
+		lifecycles:   map[string]*lifecycle{},
+		account: govultr.Account{
+			Name:  "Fake Vultr",
+			Email: "fake@example.com",
+			ACL:   []string{"manage_users", "subscriptions", "provisioning", "billing", "support", "abuse", "dns", "upgrade"},
+		},
+		instances:      newCollection[instanceRecord](),
+		blocks:         newCollection[govultr.BlockStorage](),
+		domains:        newCollection[domainRecord](),
+		firewallGroups: newCollection[firewallGroupRecord](),
+		vpcs:           newCollection[govultr.VPC](),
+		vpc2s:          newCollection[govultr.VPC2](),
+		loadBalancers:  newCollection[govultr.LoadBalancer](),
+		databases:      newCollection[databaseRecord](),
+		clusters:       newCollection[govultr.Cluster](),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.registerAccountRoutes()
+	s.registerInstanceRoutes()
+	s.registerBlockStorageRoutes()
+	s.registerDomainRoutes()
+	s.registerFirewallRoutes()
+	s.registerVPCRoutes()
+	s.registerLoadBalancerRoutes()
+	s.registerDatabaseRoutes()
+	s.registerKubernetesRoutes()
+
+	s.httpServer = httptest.NewServer(s)
+	return s
+}
+
+// URL returns the base URL to hand to the provider's api_endpoint
+func (s *Server) URL() string {
+	return s.httpServer.URL
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// ServeHTTP authenticates the request and dispatches it while holding the
+// state lock so handlers never race with each other.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.APIKey != "" && r.Header.Get("Authorization") != "Bearer "+s.APIKey {
+		writeError(w, http.StatusUnauthorized, "Invalid API token.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The mux answers unknown routes in plain text, which govultr can't
+	// decode, so they get the same error envelope as everything else
+	if h, pattern := s.mux.Handler(r); pattern == "" {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, r)
+		writeError(w, rec.Code, http.StatusText(rec.Code))
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// handle registers a route; unknown routes get a JSON 404 from ServeHTTP
+func (s *Server) handle(pattern string, h http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, h)
+}
+
+// newID returns a deterministic UUID shaped identifier
+func (s *Server) newID() string {
+	s.seq++
+	return fmt.Sprintf("cb676a46-66fd-4dfb-b839-%012d", s.seq)
+}
+
+// newIP returns a unique address from the TEST-NET-1 documentation range
+func (s *Server) newIP() string {
+	s.seq++
+	return fmt.Sprintf("192.0.2.%d", s.seq%254+1)
+}
+
+// schedule registers settle to be run once the object identified by key
+// has been read pendingReads times.
+func (s *Server) schedule(key string, settle func()) {
+	if s.pendingReads <= 0 {
+		settle()
+		return
+	}
+	s.lifecycles[key] = &lifecycle{remaining: s.pendingReads, settle: settle}
+}
+
+// tick records a read of the object identified by key
+func (s *Server) tick(key string) {
+	l, ok := s.lifecycles[key]
+	if !ok {
+		return
+	}
+
+	l.remaining--
+	if l.remaining <= 0 {
+		delete(s.lifecycles, key)
+		l.settle()
+	}
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+// writeError mirrors the Vultr error envelope
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error":  message,
+		"status": status,
+	})
+}
+
+func writeNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decode reads the JSON body into v, writing a 400 on failure
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if r.Body == nil {
+		return true
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && !strings.Contains(err.Error(), "EOF") {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return false
+	}
+
+	return true
+}
+
+// list writes a paginated list response under key
+func list[T any](w http.ResponseWriter, r *http.Request, key string, items []T) {
+	page, meta, err := paginate(r, items)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		key:    page,
+		"meta": meta,
+	})
+}
@@ -0,0 +1,396 @@
+package fakevultr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/vultr/govultr/v3"
+	"golang.org/x/oauth2"
+)
+
+func newTestClient(t *testing.T, s *Server) *govultr.Client {
+	t.Helper()
+	return newTestClientWithKey(t, s, s.APIKey)
+}
+
+func newTestClientWithKey(t *testing.T, s *Server, apiKey string) *govultr.Client {
+	t.Helper()
+
+	httpClient := oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: apiKey}))
+	client := govultr.NewClient(httpClient)
+	if err := client.SetBaseURL(s.URL()); err != nil {
+		t.Fatalf("error setting base url: %v", err)
+	}
+	client.SetRetryLimit(0)
+
+	return client
+}
+
+func TestUnauthorized(t *testing.T) {
+	s := New("secret")
+	defer s.Close()
+
+	client := newTestClientWithKey(t, s, "wrong")
+
+	_, resp, err := client.Account.Get(context.Background())
+	if err == nil {
+		t.Fatal("expected an error for a bad api key")
+	}
+	if resp == nil || resp.StatusCode != 401 {
+		t.Fatalf("expected a 401 response, got %v", resp)
+	}
+}
+
+func TestUnknownRoute(t *testing.T) {
+	s := New("secret")
+	defer s.Close()
+
+	client := newTestClient(t, s)
+
+	_, resp, err := client.Snapshot.Get(context.Background(), "missing")
+	if err == nil {
+		t.Fatal("expected an error for a route the fake doesn't serve")
+	}
+	if resp == nil || resp.StatusCode != 404 {
+		t.Fatalf("expected a 404 response, got %v", resp)
+	}
+
+	var body struct {
+		Error  string `json:"error"`
+		Status int    `json:"status"`
+	}
+	if err := json.Unmarshal([]byte(err.Error()), &body); err != nil {
+		t.Fatalf("expected a JSON error body, got %q: %v", err.Error(), err)
+	}
+	if body.Status != 404 || body.Error == "" {
+		t.Errorf("expected a 404 error envelope, got %+v", body)
+	}
+}
+
+func TestInstanceLifecycle(t *testing.T) {
+	s := New("secret", WithPendingReads(2))
+	defer s.Close()
+
+	ctx := context.Background()
+	client := newTestClient(t, s)
+
+	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{
+		Region: "ewr",
+		Plan:   "vc2-1c-1gb",
+		Label:  "test",
+		OsID:   1743,
+		Tags:   []string{"a"},
+	})
+	if err != nil {
+		t.Fatalf("error creating instance: %v", err)
+	}
+	if instance.Status != "pending" || instance.DefaultPassword == "" {
+		t.Fatalf("unexpected created instance %+v", instance)
+	}
+
+	for _, want := range []string{"pending", "active", "active"} {
+		got, _, err := client.Instance.Get(ctx, instance.ID)
+		if err != nil {
+			t.Fatalf("error getting instance: %v", err)
+		}
+		if got.Status != want {
+			t.Fatalf("expected status %s, got %s", want, got.Status)
+		}
+	}
+
+	updated, _, err := client.Instance.Update(ctx, instance.ID, &govultr.InstanceUpdateReq{Label: "renamed", Tags: []string{"b", "c"}})
+	if err != nil {
+		t.Fatalf("error updating instance: %v", err)
+	}
+	if updated.Label != "renamed" || len(updated.Tags) != 2 {
+		t.Fatalf("unexpected updated instance %+v", updated)
+	}
+
+	if err := client.Instance.Halt(ctx, instance.ID); err != nil {
+		t.Fatalf("error halting instance: %v", err)
+	}
+	got, _, _ := client.Instance.Get(ctx, instance.ID)
+	if got.PowerStatus != "stopped" {
+		t.Fatalf("expected power_status stopped, got %s", got.PowerStatus)
+	}
+
+	if err := client.Instance.Delete(ctx, instance.ID); err != nil {
+		t.Fatalf("error deleting instance: %v", err)
+	}
+
+	_, resp, err := client.Instance.Get(ctx, instance.ID)
+	if err == nil || !strings.Contains(err.Error(), "invalid instance ID") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if resp.StatusCode != 404 {
+		t.Fatalf("expected a 404 response, got %d", resp.StatusCode)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	s := New("secret")
+	defer s.Close()
+
+	ctx := context.Background()
+	client := newTestClient(t, s)
+
+	for i := 0; i < 7; i++ {
+		if _, _, err := client.VPC.Create(ctx, &govultr.VPCReq{Region: "ewr", Description: fmt.Sprintf("vpc-%d", i)}); err != nil {
+			t.Fatalf("error creating vpc: %v", err)
+		}
+	}
+
+	var descriptions []string
+	options := &govultr.ListOptions{PerPage: 3}
+	pages := 0
+	for {
+		vpcs, meta, _, err := client.VPC.List(ctx, options)
+		if err != nil {
+			t.Fatalf("error listing vpcs: %v", err)
+		}
+		pages++
+
+		if meta.Total != 7 {
+			t.Fatalf("expected total of 7, got %d", meta.Total)
+		}
+
+		for _, v := range vpcs {
+			descriptions = append(descriptions, v.Description)
+		}
+
+		if meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	if pages != 3 {
+		t.Fatalf("expected 3 pages, got %d", pages)
+	}
+	if len(descriptions) != 7 || descriptions[0] != "vpc-0" || descriptions[6] != "vpc-6" {
+		t.Fatalf("unexpected vpcs %v", descriptions)
+	}
+}
+
+func TestInstanceVPCAttachments(t *testing.T) {
+	s := New("secret", WithPendingReads(0))
+	defer s.Close()
+
+	ctx := context.Background()
+	client := newTestClient(t, s)
+
+	vpc, _, err := client.VPC2.Create(ctx, &govultr.VPC2Req{Region: "ewr"})
+	if err != nil {
+		t.Fatalf("error creating vpc2: %v", err)
+	}
+
+	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 1743})
+	if err != nil {
+		t.Fatalf("error creating instance: %v", err)
+	}
+
+	if err := client.Instance.AttachVPC2(ctx, instance.ID, &govultr.AttachVPC2Req{VPCID: vpc.ID}); err != nil {
+		t.Fatalf("error attaching vpc2: %v", err)
+	}
+
+	if err := client.VPC2.Delete(ctx, vpc.ID); err == nil {
+		t.Fatal("expected deleting an attached vpc2 to fail")
+	}
+
+	attached, _, _, err := client.Instance.ListVPC2Info(ctx, instance.ID, nil)
+	if err != nil {
+		t.Fatalf("error listing attached vpc2s: %v", err)
+	}
+	if len(attached) != 1 || attached[0].ID != vpc.ID {
+		t.Fatalf("unexpected attached vpc2s %+v", attached)
+	}
+
+	if err := client.Instance.DetachVPC2(ctx, instance.ID, vpc.ID); err != nil {
+		t.Fatalf("error detaching vpc2: %v", err)
+	}
+
+	if err := client.VPC2.Delete(ctx, vpc.ID); err != nil {
+		t.Fatalf("error deleting vpc2: %v", err)
+	}
+}
+
+func TestInstanceRebuildLag(t *testing.T) {
+	s := New("secret", WithPendingReads(0), WithRebuildLag(2))
+	defer s.Close()
+
+	ctx := context.Background()
+	client := newTestClient(t, s)
+
+	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{Region: "ewr", Plan: "vc2-1c-1gb", OsID: 1743})
+	if err != nil {
+		t.Fatalf("error creating instance: %v", err)
+	}
+
+	if _, _, err := client.Instance.Reinstall(ctx, instance.ID, &govultr.ReinstallReq{}); err != nil {
+		t.Fatalf("error reinstalling instance: %v", err)
+	}
+
+	var statuses []string
+	for range 4 {
+		got, _, err := client.Instance.Get(ctx, instance.ID)
+		if err != nil {
+			t.Fatalf("error getting instance: %v", err)
+		}
+		statuses = append(statuses, got.Status+"/"+got.ServerStatus)
+	}
+
+	want := []string{"active/ok", "pending/installingbooting", "pending/installingbooting", "active/ok"}
+	if !slices.Equal(statuses, want) {
+		t.Fatalf("expected the reinstall to show up after a lag, got %v", statuses)
+	}
+}
+
+func TestDatabaseLifecycle(t *testing.T) {
+	s := New("secret")
+	defer s.Close()
+
+	ctx := context.Background()
+	client := newTestClient(t, s)
+
+	db, _, err := client.Database.Create(ctx, &govultr.DatabaseCreateReq{
+		DatabaseEngine:        "pg",
+		DatabaseEngineVersion: "16",
+		Region:                "ewr",
+		Plan:                  "vultr-dbaas-startup-cc-1-55-2",
+		Label:                 "test",
+	})
+	if err != nil {
+		t.Fatalf("error creating database: %v", err)
+	}
+	if db.Status != "Rebuilding" {
+		t.Fatalf("expected status Rebuilding, got %s", db.Status)
+	}
+
+	got, _, _ := client.Database.Get(ctx, db.ID)
+	if got.Status != "Running" {
+		t.Fatalf("expected status Running, got %s", got.Status)
+	}
+
+	if _, _, err := client.Database.UpdateUser(ctx, db.ID, "vultradmin", &govultr.DatabaseUserUpdateReq{Password: "rotated"}); err != nil {
+		t.Fatalf("error updating default user: %v", err)
+	}
+
+	got, _, _ = client.Database.Get(ctx, db.ID)
+	if got.Password != "rotated" {
+		t.Fatalf("expected rotated password, got %s", got.Password)
+	}
+
+	if err := client.Database.Delete(ctx, db.ID); err != nil {
+		t.Fatalf("error deleting database: %v", err)
+	}
+
+	if _, _, err := client.Database.Get(ctx, db.ID); err == nil || !strings.Contains(err.Error(), "invalid database ID") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestKubernetesLifecycle(t *testing.T) {
+	s := New("secret")
+	defer s.Close()
+
+	ctx := context.Background()
+	client := newTestClient(t, s)
+
+	cluster, _, err := client.Kubernetes.CreateCluster(ctx, &govultr.ClusterReq{
+		Label:   "test",
+		Region:  "ewr",
+		Version: kubernetesVersions[0],
+		NodePools: []govultr.NodePoolReq{
+			{NodeQuantity: 2, Label: "default", Plan: "vc2-2c-4gb", Tag: "tf-vke-default"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error creating cluster: %v", err)
+	}
+
+	got, _, _ := client.Kubernetes.GetCluster(ctx, cluster.ID)
+	if got.Status != "active" || got.NodePools[0].Status != "active" || len(got.NodePools[0].Nodes) != 2 {
+		t.Fatalf("unexpected cluster %+v", got)
+	}
+
+	np := got.NodePools[0]
+	if _, _, err := client.Kubernetes.UpdateNodePool(ctx, cluster.ID, np.ID, &govultr.NodePoolReqUpdate{NodeQuantity: 3}); err != nil {
+		t.Fatalf("error updating node pool: %v", err)
+	}
+
+	pool, _, err := client.Kubernetes.GetNodePool(ctx, cluster.ID, np.ID)
+	if err != nil {
+		t.Fatalf("error getting node pool: %v", err)
+	}
+	if len(pool.Nodes) != 3 {
+		t.Fatalf("expected 3 nodes, got %d", len(pool.Nodes))
+	}
+
+	kc, _, err := client.Kubernetes.GetKubeConfig(ctx, cluster.ID)
+	if err != nil || kc.KubeConfig == "" {
+		t.Fatalf("error getting kubeconfig: %v", err)
+	}
+
+	if err := client.Kubernetes.DeleteCluster(ctx, cluster.ID); err != nil {
+		t.Fatalf("error deleting cluster: %v", err)
+	}
+
+	if _, _, err := client.Kubernetes.GetCluster(ctx, cluster.ID); err == nil || !strings.Contains(err.Error(), "Invalid resource ID") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestDNSAndFirewall(t *testing.T) {
+	s := New("secret")
+	defer s.Close()
+
+	ctx := context.Background()
+	client := newTestClient(t, s)
+
+	if _, _, err := client.Domain.Create(ctx, &govultr.DomainReq{Domain: "example.com", IP: "192.0.2.10"}); err != nil {
+		t.Fatalf("error creating domain: %v", err)
+	}
+
+	record, _, err := client.DomainRecord.Create(ctx, "example.com", &govultr.DomainRecordReq{Name: "www", Type: "CNAME", Data: "example.com"})
+	if err != nil {
+		t.Fatalf("error creating record: %v", err)
+	}
+
+	records, _, _, err := client.DomainRecord.List(ctx, "example.com", nil)
+	if err != nil || len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d: %v", len(records), err)
+	}
+
+	if err := client.DomainRecord.Delete(ctx, "example.com", record.ID); err != nil {
+		t.Fatalf("error deleting record: %v", err)
+	}
+
+	group, _, err := client.FirewallGroup.Create(ctx, &govultr.FirewallGroupReq{Description: "web"})
+	if err != nil {
+		t.Fatalf("error creating firewall group: %v", err)
+	}
+
+	rule, _, err := client.FirewallRule.Create(ctx, group.ID, &govultr.FirewallRuleReq{
+		IPType: "v4", Protocol: "tcp", Subnet: "0.0.0.0", SubnetSize: 0, Port: "443",
+	})
+	if err != nil {
+		t.Fatalf("error creating firewall rule: %v", err)
+	}
+
+	got, _, _ := client.FirewallGroup.Get(ctx, group.ID)
+	if got.RuleCount != 1 {
+		t.Fatalf("expected rule count 1, got %d", got.RuleCount)
+	}
+
+	if err := client.FirewallRule.Delete(ctx, group.ID, rule.ID); err != nil {
+		t.Fatalf("error deleting firewall rule: %v", err)
+	}
+
+	if _, _, err := client.FirewallRule.Get(ctx, group.ID, rule.ID); err == nil || !strings.Contains(err.Error(), "Firewall rule ID not found") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package fakevultr
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// collection is an insertion ordered map of objects keyed by ID
+type collection[T any] struct {
+	ids   []string
+	items map[string]*T
+}
+
+func newCollection[T any]() *collection[T] {
+	return &collection[T]{items: map[string]*T{}}
+}
+
+func (c *collection[T]) add(id string, item *T) {
+	if _, ok := c.items[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.items[id] = item
+}
+
+func (c *collection[T]) get(id string) (*T, bool) {
+	item, ok := c.items[id]
+	return item, ok
+}
+
+func (c *collection[T]) remove(id string) bool {
+	if _, ok := c.items[id]; !ok {
+		return false
+	}
+
+	delete(c.items, id)
+	for i := range c.ids {
+		if c.ids[i] == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+
+	return true
+}
+
+func (c *collection[T]) all() []*T {
+	items := make([]*T, 0, len(c.ids))
+	for _, id := range c.ids {
+		items = append(items, c.items[id])
+	}
+	return items
+}
+
+// paginate slices items according to the per_page and cursor query params
+// and builds the matching meta block. Cursors are opaque base64 offsets just
+// like the real API's.
+func paginate[T any](r *http.Request, items []T) ([]T, *govultr.Meta, error) {
+	perPage := defaultPerPage
+	if v := r.URL.Query().Get("per_page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return nil, nil, fmt.Errorf("invalid per_page %q", v)
+		}
+		perPage = min(n, maxPerPage)
+	}
+
+	offset := 0
+	if v := r.URL.Query().Get("cursor"); v != "" {
+		n, err := decodeCursor(v)
+		if err != nil || n < 0 || n > len(items) {
+			return nil, nil, fmt.Errorf("invalid cursor %q", v)
+		}
+		offset = n
+	}
+
+	end := min(offset+perPage, len(items))
+	meta := &govultr.Meta{
+		Total: len(items),
+		Links: &govultr.Links{},
+	}
+
+	if end < len(items) {
+		meta.Links.Next = encodeCursor(end)
+	}
+	if offset > 0 {
+		meta.Links.Prev = encodeCursor(max(offset-perPage, 0))
+	}
+
+	page := make([]T, 0, end-offset)
+	page = append(page, items[offset:end]...)
+	return page, meta, nil
+}
+
+func encodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte("next__" + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	b, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+
+	var offset int
+	if _, err := fmt.Sscanf(string(b), "next__%d", &offset); err != nil {
+		return 0, err
+	}
+	return offset, nil
+}
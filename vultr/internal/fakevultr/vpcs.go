@@ -0,0 +1,178 @@
+package fakevultr
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/vultr/govultr/v3"
+)
+
+func (s *Server) registerVPCRoutes() {
+	s.handle("POST /v2/vpcs", s.createVPC)
+	s.handle("GET /v2/vpcs", s.listVPCs)
+	s.handle("GET /v2/vpcs/{id}", s.withVPC(s.getVPC))
+	s.handle("PUT /v2/vpcs/{id}", s.withVPC(s.updateVPC))
+	s.handle("DELETE /v2/vpcs/{id}", s.withVPC(s.deleteVPC))
+
+	s.handle("POST /v2/vpc2", s.createVPC2)
+	s.handle("GET /v2/vpc2", s.listVPC2s)
+	s.handle("GET /v2/vpc2/{id}", s.withVPC2(s.getVPC2))
+	s.handle("PUT /v2/vpc2/{id}", s.withVPC2(s.updateVPC2))
+	s.handle("DELETE /v2/vpc2/{id}", s.withVPC2(s.deleteVPC2))
+}
+
+func (s *Server) withVPC(h func(http.ResponseWriter, *http.Request, *govultr.VPC)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vpc, ok := s.vpcs.get(r.PathValue("id"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Invalid VPC ID")
+			return
+		}
+		h(w, r, vpc)
+	}
+}
+
+func (s *Server) withVPC2(h func(http.ResponseWriter, *http.Request, *govultr.VPC2)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vpc, ok := s.vpc2s.get(r.PathValue("id"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Invalid VPC 2.0 ID")
+			return
+		}
+		h(w, r, vpc)
+	}
+}
+
+func (s *Server) createVPC(w http.ResponseWriter, r *http.Request) {
+	var req govultr.VPCReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Region == "" {
+		writeError(w, http.StatusBadRequest, "region is required")
+		return
+	}
+
+	vpc := &govultr.VPC{
+		ID:           s.newID(),
+		Region:       req.Region,
+		Description:  req.Description,
+		V4Subnet:     req.V4Subnet,
+		V4SubnetMask: req.V4SubnetMask,
+		DateCreated:  now(),
+	}
+	if vpc.V4Subnet == "" {
+		vpc.V4Subnet = "10.0.0.0"
+		vpc.V4SubnetMask = 24
+	}
+
+	s.vpcs.add(vpc.ID, vpc)
+
+	created := *vpc
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"vpc": &created})
+}
+
+func (s *Server) listVPCs(w http.ResponseWriter, r *http.Request) {
+	var vpcs []govultr.VPC
+	for _, vpc := range s.vpcs.all() {
+		vpcs = append(vpcs, *vpc)
+	}
+	list(w, r, "vpcs", vpcs)
+}
+
+func (s *Server) getVPC(w http.ResponseWriter, _ *http.Request, vpc *govultr.VPC) {
+	v := *vpc
+	writeJSON(w, http.StatusOK, map[string]interface{}{"vpc": &v})
+}
+
+func (s *Server) updateVPC(w http.ResponseWriter, r *http.Request, vpc *govultr.VPC) {
+	var req struct {
+		Description string `json:"description"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	vpc.Description = req.Description
+	writeNoContent(w)
+}
+
+func (s *Server) deleteVPC(w http.ResponseWriter, _ *http.Request, vpc *govultr.VPC) {
+	for _, rec := range s.instances.all() {
+		if slices.Contains(rec.vpcs, vpc.ID) {
+			writeError(w, http.StatusBadRequest, "VPC is attached to one or more servers")
+			return
+		}
+	}
+
+	s.vpcs.remove(vpc.ID)
+	writeNoContent(w)
+}
+
+func (s *Server) createVPC2(w http.ResponseWriter, r *http.Request) {
+	var req govultr.VPC2Req
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Region == "" {
+		writeError(w, http.StatusBadRequest, "region is required")
+		return
+	}
+
+	vpc := &govultr.VPC2{
+		ID:           s.newID(),
+		Region:       req.Region,
+		Description:  req.Description,
+		IPBlock:      req.IPBlock,
+		PrefixLength: req.PrefixLength,
+		DateCreated:  now(),
+	}
+	if vpc.IPBlock == "" {
+		vpc.IPBlock = "10.1.0.0"
+		vpc.PrefixLength = 24
+	}
+
+	s.vpc2s.add(vpc.ID, vpc)
+
+	created := *vpc
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"vpc": &created})
+}
+
+func (s *Server) listVPC2s(w http.ResponseWriter, r *http.Request) {
+	var vpcs []govultr.VPC2
+	for _, vpc := range s.vpc2s.all() {
+		vpcs = append(vpcs, *vpc)
+	}
+	list(w, r, "vpcs", vpcs)
+}
+
+func (s *Server) getVPC2(w http.ResponseWriter, _ *http.Request, vpc *govultr.VPC2) {
+	v := *vpc
+	writeJSON(w, http.StatusOK, map[string]interface{}{"vpc": &v})
+}
+
+func (s *Server) updateVPC2(w http.ResponseWriter, r *http.Request, vpc *govultr.VPC2) {
+	var req struct {
+		Description string `json:"description"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	vpc.Description = req.Description
+	writeNoContent(w)
+}
+
+func (s *Server) deleteVPC2(w http.ResponseWriter, _ *http.Request, vpc *govultr.VPC2) {
+	for _, rec := range s.instances.all() {
+		if slices.Contains(rec.vpc2s, vpc.ID) {
+			writeError(w, http.StatusBadRequest, "servers are attached to this VPC 2.0 network: "+rec.instance.ID)
+			return
+		}
+	}
+
+	s.vpc2s.remove(vpc.ID)
+	writeNoContent(w)
+}
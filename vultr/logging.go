@@ -0,0 +1,121 @@
+package vultr
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/terraform-provider-vultr/vultr/internal/cassette"
+)
+
+// Fields added to every log line written while a resource or data source
+// operation runs
+const (
+	logFieldResourceType = "vultr_resource_type"
+	logFieldResourceID   = "vultr_resource_id"
+	logFieldOperation    = "vultr_operation"
+)
+
+// apiLogSubsystem is the tflog subsystem the API traffic is logged under. Its
+// level can be set separately with TF_LOG_PROVIDER_VULTR_API.
+const apiLogSubsystem = "api"
+
+// Fields of the API request and response log lines
+const (
+	logFieldHTTPMethod = "vultr_http_method"
+	logFieldHTTPURI    = "vultr_http_uri"
+	logFieldHTTPStatus = "vultr_http_status"
+	logFieldHTTPBody   = "vultr_http_body"
+	logFieldRequestID  = "vultr_request_id"
+)
+
+// requestIDHeader identifies an API call when raising it with Vultr support
+const requestIDHeader = "X-Request-Id"
+
+type contextFunc interface {
+	~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+}
+
+// withLogFields wraps f so that the context it receives logs the resource
+// type, operation and, once known, the resource ID
+func withLogFields[F contextFunc](resourceType, operation string, f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx = tflog.SetField(ctx, logFieldResourceType, resourceType)
+		ctx = tflog.SetField(ctx, logFieldOperation, operation)
+		if d.Id() != "" {
+			ctx = tflog.SetField(ctx, logFieldResourceID, d.Id())
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// addLogFields wraps the CRUD functions of every resource in resources
+func addLogFields(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		r.CreateContext = withLogFields(name, "create", r.CreateContext)
+		r.ReadContext = withLogFields(name, "read", r.ReadContext)
+		r.UpdateContext = withLogFields(name, "update", r.UpdateContext)
+		r.DeleteContext = withLogFields(name, "delete", r.DeleteContext)
+	}
+}
+
+// apiLoggingTransport logs every API request and response at debug level,
+// with the Vultr request ID and with secrets such as passwords, kubeconfigs
+// and S3 keys redacted from the bodies. The Authorization header is never
+// logged.
+type apiLoggingTransport struct {
+	next http.RoundTripper
+}
+
+func newAPILoggingTransport(next http.RoundTripper) *apiLoggingTransport {
+	return &apiLoggingTransport{next: next}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *apiLoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), apiLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_VULTR", apiLogSubsystem))
+	ctx = tflog.SubsystemSetField(ctx, apiLogSubsystem, logFieldHTTPMethod, req.Method)
+	ctx = tflog.SubsystemSetField(ctx, apiLogSubsystem, logFieldHTTPURI, req.URL.RequestURI())
+
+	fields := map[string]interface{}{}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		fields[logFieldHTTPBody] = cassette.Scrub(string(body))
+	}
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Sending API request", fields)
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		tflog.SubsystemDebug(ctx, apiLogSubsystem, "API request failed", map[string]interface{}{"error": err.Error()})
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Received API response", map[string]interface{}{
+		logFieldHTTPStatus: resp.StatusCode,
+		logFieldRequestID:  resp.Header.Get(requestIDHeader),
+		logFieldHTTPBody:   cassette.Scrub(string(body)),
+	})
+
+	return resp, nil
+}
@@ -0,0 +1,93 @@
+package vultr
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAddLogFields(t *testing.T) {
+	r := &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			tflog.Info(ctx, "Reading thing")
+			return nil
+		},
+	}
+	addLogFields(map[string]*schema.Resource{"vultr_thing": r})
+
+	if r.CreateContext != nil {
+		t.Fatal("expected unset functions to stay unset")
+	}
+
+	var out bytes.Buffer
+	d := r.TestResourceData()
+	d.SetId("abc")
+	r.ReadContext(tflogtest.RootLogger(context.Background(), &out), d, nil)
+
+	entries, err := tflogtest.MultilineJSONDecode(&out)
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("expected one log entry, got %v %v", entries, err)
+	}
+
+	for key, want := range map[string]string{
+		logFieldResourceType: "vultr_thing",
+		logFieldResourceID:   "abc",
+		logFieldOperation:    "read",
+	} {
+		if got := entries[0][key]; got != want {
+			t.Errorf("expected %s to be %q, got %v", key, want, got)
+		}
+	}
+}
+
+func TestAPILoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "req-123")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"instance":{"id":"abc","default_password":"hunter2"}}`))
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &out)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v2/instances", strings.NewReader(`{"label":"web","password":"s3cret"}`))
+	req.Header.Set("Authorization", "Bearer api-key")
+
+	client := &http.Client{Transport: newAPILoggingTransport(http.DefaultTransport)}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "hunter2") {
+		t.Fatalf("expected the caller to get the unredacted body, got %s", body)
+	}
+
+	for _, secret := range []string{"hunter2", "s3cret", "api-key"} {
+		if strings.Contains(out.String(), secret) {
+			t.Fatalf("expected %q to be redacted from the logs:\n%s", secret, out.String())
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&out)
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("expected a request and a response entry, got %v %v", entries, err)
+	}
+	if got := entries[1][logFieldRequestID]; got != "req-123" {
+		t.Fatalf("expected the request ID to be logged, got %v", got)
+	}
+	if got := entries[0][logFieldHTTPURI]; got != "/v2/instances" {
+		t.Fatalf("expected the request URI to be logged, got %v", got)
+	}
+}
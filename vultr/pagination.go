@@ -0,0 +1,64 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/vultr/govultr/v3"
+)
+
+const (
+	// defaultPerPage is the page size used when a list call doesn't ask for
+	// one, and maxPerPage the largest the API accepts
+	defaultPerPage = 100
+	maxPerPage     = 500
+)
+
+// listPage fetches the page of a list selected by opts and returns its items
+// along with the meta holding the cursor of the next page
+type listPage[T any] func(ctx context.Context, opts *govultr.ListOptions) ([]T, *govultr.Meta, error)
+
+// listAll fetches every page of a list, following the cursors until there
+// are none left. Items are de-duplicated by key, as an item can show up on
+// two pages when the list changes while it is read. opts may be nil and is
+// not modified.
+func listAll[T any](ctx context.Context, opts *govultr.ListOptions, key func(T) string, page listPage[T]) ([]T, error) {
+	options := govultr.ListOptions{}
+	if opts != nil {
+		options = *opts
+	}
+
+	switch {
+	case options.PerPage <= 0:
+		options.PerPage = defaultPerPage
+	case options.PerPage > maxPerPage:
+		options.PerPage = maxPerPage
+	}
+
+	var all []T
+	seen := map[string]struct{}{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		items, meta, err := page(ctx, &options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			k := key(item)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			all = append(all, item)
+		}
+
+		// a cursor that doesn't move would loop forever
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" || meta.Links.Next == options.Cursor {
+			return all, nil
+		}
+		options.Cursor = meta.Links.Next
+	}
+}
@@ -0,0 +1,125 @@
+package vultr
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/vultr/govultr/v3"
+)
+
+// fakePages serves pages as a list call would, using the index of the page
+// as its cursor, and records the options of every call
+type fakePages struct {
+	pages [][]string
+	calls []govultr.ListOptions
+}
+
+func (p *fakePages) page(ctx context.Context, opts *govultr.ListOptions) ([]string, *govultr.Meta, error) {
+	p.calls = append(p.calls, *opts)
+
+	i := 0
+	if opts.Cursor != "" {
+		i, _ = strconv.Atoi(opts.Cursor)
+	}
+
+	meta := &govultr.Meta{Links: &govultr.Links{}}
+	if i+1 < len(p.pages) {
+		meta.Links.Next = strconv.Itoa(i + 1)
+	}
+	return p.pages[i], meta, nil
+}
+
+func identity(s string) string { return s }
+
+func TestListAll(t *testing.T) {
+	p := &fakePages{pages: [][]string{{"a", "b"}, {"b", "c"}, {"d"}}}
+
+	got, err := listAll(context.Background(), nil, identity, p.page)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"a", "b", "c", "d"}; !slices.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if len(p.calls) != 3 {
+		t.Fatalf("expected 3 pages to be fetched, got %d", len(p.calls))
+	}
+}
+
+func TestListAllPerPage(t *testing.T) {
+	cases := map[string]struct {
+		opts *govultr.ListOptions
+		want int
+	}{
+		"default":  {nil, defaultPerPage},
+		"kept":     {&govultr.ListOptions{PerPage: 10}, 10},
+		"capped":   {&govultr.ListOptions{PerPage: 1000}, maxPerPage},
+		"negative": {&govultr.ListOptions{PerPage: -1}, defaultPerPage},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &fakePages{pages: [][]string{{"a"}, {"b"}}}
+			if _, err := listAll(context.Background(), tc.opts, identity, p.page); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, call := range p.calls {
+				if call.PerPage != tc.want {
+					t.Fatalf("expected a page size of %d, got %d", tc.want, call.PerPage)
+				}
+			}
+		})
+	}
+
+	opts := &govultr.ListOptions{PerPage: 1000}
+	p := &fakePages{pages: [][]string{{"a"}, {"b"}}}
+	if _, err := listAll(context.Background(), opts, identity, p.page); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.PerPage != 1000 || opts.Cursor != "" {
+		t.Fatalf("expected the options of the caller to be left alone, got %+v", opts)
+	}
+}
+
+func TestListAllCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &fakePages{pages: [][]string{{"a"}, {"b"}}}
+
+	_, err := listAll(ctx, nil, identity, func(ctx context.Context, opts *govultr.ListOptions) ([]string, *govultr.Meta, error) {
+		cancel()
+		return p.page(ctx, opts)
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the list to stop when cancelled, got %v", err)
+	}
+	if len(p.calls) != 1 {
+		t.Fatalf("expected 1 page to be fetched, got %d", len(p.calls))
+	}
+}
+
+func TestListAllError(t *testing.T) {
+	wantErr := errors.New("boom")
+	_, err := listAll(context.Background(), nil, identity, func(ctx context.Context, opts *govultr.ListOptions) ([]string, *govultr.Meta, error) {
+		return nil, nil, wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected the page error, got %v", err)
+	}
+}
+
+func TestListAllStuckCursor(t *testing.T) {
+	calls := 0
+	got, err := listAll(context.Background(), nil, identity, func(ctx context.Context, opts *govultr.ListOptions) ([]string, *govultr.Meta, error) {
+		calls++
+		if calls > 2 {
+			t.Fatal("expected a cursor that doesn't move to end the list")
+		}
+		return []string{"a"}, &govultr.Meta{Links: &govultr.Links{Next: "same"}}, nil
+	})
+	if err != nil || !slices.Equal(got, []string{"a"}) {
+		t.Fatalf("unexpected result: %v %v", got, err)
+	}
+}
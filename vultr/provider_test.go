@@ -2,11 +2,19 @@ package vultr
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/terraform-provider-vultr/vultr/internal/fakevultr"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -28,12 +36,169 @@ func init() {
 	}
 }
 
+// TestMain points the acceptance tests at an in-memory fake of the Vultr API
+// when VULTR_FAKE_API is set, so they can run without real credentials.
+func TestMain(m *testing.M) {
+	if os.Getenv("VULTR_FAKE_API") == "" {
+		os.Exit(m.Run())
+	}
+
+	server := fakevultr.New("fake-api-key")
+	os.Setenv("VULTR_API_KEY", server.APIKey)
+	os.Setenv("VULTR_API_ENDPOINT", server.URL())
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"rate_limit": 2000, "retry_limit": 4})
+	testAccProvider.Configure(context.Background(), config)
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
+// testFakeProvider returns a provider configured against a fake API, where
+// objects settle on their first read, along with a client for setting up
+// fixtures
+func testFakeProvider(t *testing.T) (*schema.Provider, *govultr.Client) {
+	t.Helper()
+
+	p, client, _ := testFakeProviderAPI(t)
+	return p, client
+}
+
+// testFakeProviderAPI is testFakeProvider, but also returns the fake API for
+// inspecting what the provider did and takes options for it
+func testFakeProviderAPI(t *testing.T, opts ...fakevultr.Option) (*schema.Provider, *govultr.Client, *fakevultr.Server) {
+	t.Helper()
+
+	api := fakevultr.New("fake-api-key", append([]fakevultr.Option{fakevultr.WithPendingReads(0)}, opts...)...)
+	t.Cleanup(api.Close)
+
+	delay, interval, rebuildStart := defaultWaitDelay, defaultPollInterval, instanceRebuildStartTimeout
+	defaultWaitDelay, defaultPollInterval = time.Millisecond, time.Millisecond
+	instanceRebuildStartTimeout = 100 * time.Millisecond
+	t.Cleanup(func() {
+		defaultWaitDelay, defaultPollInterval, instanceRebuildStartTimeout = delay, interval, rebuildStart
+	})
+
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"api_key":      api.APIKey,
+		"api_endpoint": api.URL(),
+	})); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	return p, p.Meta().(*Client).govultrClient(), api
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
 
+func TestProviderAPIEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/account" {
+			t.Errorf("unexpected request path %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			t.Errorf("unexpected authorization header %q", r.Header.Get("Authorization"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"account":{"name":"mock","email":"mock@example.com"}}`))
+	}))
+	defer server.Close()
+
+	p := Provider()
+	raw := map[string]interface{}{
+		"api_key":      "test-key",
+		"api_endpoint": server.URL,
+	}
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatalf("error configuring provider: %v", diags)
+	}
+
+	account, _, err := p.Meta().(*Client).govultrClient().Account.Get(context.Background())
+	if err != nil {
+		t.Fatalf("error getting account from mock API: %v", err)
+	}
+
+	if account.Name != "mock" {
+		t.Fatalf("expected account name mock, got %s", account.Name)
+	}
+}
+
+func TestConfigInvalidAPIEndpoint(t *testing.T) {
+	for _, endpoint := range []string{"api.vultr.com", "ftp://api.vultr.com", "https://"} {
+		config := Config{APIKey: "test-key", APIEndpoint: endpoint}
+		if _, err := config.Client(); err == nil {
+			t.Errorf("expected error for api_endpoint %q", endpoint)
+		}
+	}
+}
+
+func TestProviderConfigureValidatesCredentials(t *testing.T) {
+	cases := map[string]struct {
+		status  int
+		body    string
+		skip    bool
+		summary string
+	}{
+		"valid":        {status: http.StatusOK, body: `{"account":{"name":"mock"}}`},
+		"invalid key":  {status: http.StatusUnauthorized, body: `{"error":"Invalid API token.","status":401}`, summary: "Invalid API key"},
+		"ip":           {status: http.StatusUnauthorized, body: `{"error":"Unauthorized IP address: 192.0.2.1","status":401}`, summary: "API key is restricted to other IP addresses"},
+		"missing acl":  {status: http.StatusForbidden, body: `{"error":"Forbidden","status":403}`, summary: "API key is missing a required ACL"},
+		"skipped":      {status: http.StatusUnauthorized, body: `{"error":"Invalid API token.","status":401}`, skip: true},
+		"bad request":  {status: http.StatusBadRequest, body: `{"error":"Bad request","status":400}`, summary: "Unable to validate API key"},
+		"server error": {status: http.StatusServiceUnavailable, body: `{"error":"Service unavailable","status":503}`, summary: "Unable to validate API key"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			raw := map[string]interface{}{
+				"api_key":                     "test-key",
+				"api_endpoint":                server.URL,
+				"skip_credentials_validation": tc.skip,
+				// Server errors are retried, so keep the backoff short
+				"rate_limit": 1,
+			}
+			diags := Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+
+			if tc.skip && requests != 0 {
+				t.Fatalf("expected no API calls when validation is skipped, got %d", requests)
+			}
+
+			if tc.summary == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error configuring provider: %v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 || diags[0].Summary != tc.summary {
+				t.Fatalf("expected a %q diagnostic, got %v", tc.summary, diags)
+			}
+			authError := tc.status == http.StatusUnauthorized || tc.status == http.StatusForbidden
+			if authError && !diags[0].AttributePath.Equals(cty.GetAttrPath("api_key")) {
+				t.Fatalf("expected the diagnostic to point at api_key, got %#v", diags[0].AttributePath)
+			}
+			if !authError && !strings.Contains(diags[0].Detail, strconv.Itoa(tc.status)) {
+				t.Fatalf("expected the diagnostic to report status %d, got %q", tc.status, diags[0].Detail)
+			}
+		})
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("VULTR_API_KEY"); v == "" {
 		t.Fatal("VULTR_API_KEY must be set for acceptance tests")
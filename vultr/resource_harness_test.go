@@ -0,0 +1,143 @@
+package vultr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testPlanResource validates and plans a resource through the provider's gRPC
+// server, the way Terraform does
+func testPlanResource(
+	t *testing.T, p *schema.Provider, typeName string, prior cty.Value, config map[string]cty.Value,
+) *tfprotov5.PlanResourceChangeResponse {
+	t.Helper()
+
+	plan := testPlanResourceChange(t, p, typeName, prior, config)
+	for _, d := range plan.Diagnostics {
+		t.Fatalf("plan: %s: %s", d.Summary, d.Detail)
+	}
+
+	return plan
+}
+
+// testPlanResourceChange is testPlanResource, but leaves the diagnostics of
+// the plan to the caller
+func testPlanResourceChange(
+	t *testing.T, p *schema.Provider, typeName string, prior cty.Value, config map[string]cty.Value,
+) *tfprotov5.PlanResourceChangeResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	configVal := testConfigValue(p, typeName, config)
+	schemaMap := p.ResourcesMap[typeName].Schema
+
+	// Terraform proposes the config, with the prior state of computed
+	// attributes, as the new state. Write-only arguments are never proposed.
+	proposed := map[string]cty.Value{}
+	for name, v := range configVal.AsValueMap() {
+		if !prior.IsNull() && v.IsNull() {
+			v = prior.GetAttr(name)
+		}
+		if s, ok := schemaMap[name]; ok && s.WriteOnly {
+			v = cty.NullVal(v.Type())
+		}
+		proposed[name] = v
+	}
+
+	server := p.GRPCProvider()
+
+	validate, err := server.ValidateResourceTypeConfig(ctx, &tfprotov5.ValidateResourceTypeConfigRequest{
+		TypeName:           typeName,
+		Config:             testDynamicValue(t, p, typeName, configVal),
+		ClientCapabilities: &tfprotov5.ValidateResourceTypeConfigClientCapabilities{WriteOnlyAttributesAllowed: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range validate.Diagnostics {
+		t.Fatalf("validate: %s: %s", d.Summary, d.Detail)
+	}
+
+	plan, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       testDynamicValue(t, p, typeName, prior),
+		ProposedNewState: testDynamicValue(t, p, typeName, cty.ObjectVal(proposed)),
+		Config:           testDynamicValue(t, p, typeName, configVal),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return plan
+}
+
+// testApplyResource plans and applies a resource through the provider's gRPC
+// server, the way Terraform does, and returns the new state
+func testApplyResource(
+	t *testing.T, p *schema.Provider, typeName string, prior cty.Value, config map[string]cty.Value,
+) cty.Value {
+	t.Helper()
+
+	apply := testApplyResourceChange(t, p, typeName, prior, config)
+	for _, d := range apply.Diagnostics {
+		t.Fatalf("apply: %s: %s", d.Summary, d.Detail)
+	}
+
+	state, err := msgpack.Unmarshal(apply.NewState.MsgPack, p.ResourcesMap[typeName].CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
+// testApplyResourceChange is testApplyResource, but leaves the diagnostics of
+// the apply to the caller
+func testApplyResourceChange(
+	t *testing.T, p *schema.Provider, typeName string, prior cty.Value, config map[string]cty.Value,
+) *tfprotov5.ApplyResourceChangeResponse {
+	t.Helper()
+
+	plan := testPlanResource(t, p, typeName, prior, config)
+
+	apply, err := p.GRPCProvider().ApplyResourceChange(context.Background(), &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:       typeName,
+		PriorState:     testDynamicValue(t, p, typeName, prior),
+		PlannedState:   plan.PlannedState,
+		Config:         testDynamicValue(t, p, typeName, testConfigValue(p, typeName, config)),
+		PlannedPrivate: plan.PlannedPrivate,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return apply
+}
+
+// testConfigValue builds the config of a resource, leaving the attributes not
+// in config null
+func testConfigValue(p *schema.Provider, typeName string, config map[string]cty.Value) cty.Value {
+	attrs := map[string]cty.Value{}
+	for name, attrType := range p.ResourcesMap[typeName].CoreConfigSchema().ImpliedType().AttributeTypes() {
+		if v, ok := config[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = cty.NullVal(attrType)
+		}
+	}
+	return cty.ObjectVal(attrs)
+}
+
+func testDynamicValue(t *testing.T, p *schema.Provider, typeName string, v cty.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	b, err := msgpack.Marshal(v, p.ResourcesMap[typeName].CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &tfprotov5.DynamicValue{MsgPack: b}
+}
@@ -5,12 +5,10 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vultr/govultr/v3"
 )
@@ -21,6 +19,7 @@ func resourceVultrBareMetalServer() *schema.Resource {
 		ReadContext:   resourceVultrBareMetalServerRead,
 		UpdateContext: resourceVultrBareMetalServerUpdate,
 		DeleteContext: resourceVultrBareMetalServerDelete,
+		CustomizeDiff: customizeDiffTagsAll,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -50,6 +49,7 @@ func resourceVultrBareMetalServer() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Default:  nil,
 			},
+			"tags_all": tagsAllSchema(),
 			"script_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -257,11 +257,7 @@ func resourceVultrBareMetalServerCreate(ctx context.Context, d *schema.ResourceD
 		req.ImageID = imageID.(string)
 	}
 
-	if tagsIDs, tagsOK := d.GetOk("tags"); tagsOK {
-		for _, v := range tagsIDs.(*schema.Set).List() {
-			req.Tags = append(req.Tags, v.(string))
-		}
-	}
+	req.Tags = resourceTagsWithDefaults(d, meta)
 
 	if vpcIDs, vpcOK := d.GetOk("vpc2_ids"); vpcOK {
 		for _, v := range vpcIDs.(*schema.Set).List() {
@@ -277,9 +273,9 @@ func resourceVultrBareMetalServerCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	d.SetId(bm.ID)
-	log.Printf("[INFO] Bare Metal Server ID: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Bare Metal Server ID: %s", d.Id()))
 
-	if _, err = waitForBareMetalServerActiveStatus(ctx, d, meta); err != nil {
+	if _, err = waitForBareMetalServerActiveStatus(ctx, d, d.Timeout(schema.TimeoutCreate), meta); err != nil {
 		return diag.Errorf("error while waiting for bare metal server (%s) to be in active state: %s", d.Id(), err)
 	}
 
@@ -289,14 +285,12 @@ func resourceVultrBareMetalServerCreate(ctx context.Context, d *schema.ResourceD
 func resourceVultrBareMetalServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	bms, _, err := client.BareMetalServer.Get(ctx, d.Id())
+	bms, resp, err := client.BareMetalServer.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid server") {
-			log.Printf("[WARN] Removing bare metal server %s because it is gone", d.Id())
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting bare metal server: %v", err)
+		return apiErrorf(resp, err, "error getting bare metal server: %v", err)
 	}
 
 	d.SetId(bms.ID)
@@ -339,7 +333,7 @@ func resourceVultrBareMetalServerRead(ctx context.Context, d *schema.ResourceDat
 	if err := d.Set("label", bms.Label); err != nil {
 		return diag.Errorf("unable to set resource bare_metal_server `label` read value: %v", err)
 	}
-	if err := d.Set("tags", bms.Tags); err != nil {
+	if err := setResourceTags(d, bms.Tags, meta); err != nil {
 		return diag.Errorf("unable to set resource bare_metal_server `tags` read value: %v", err)
 	}
 	if err := d.Set("mac_address", bms.MacAddress); err != nil {
@@ -367,7 +361,7 @@ func resourceVultrBareMetalServerRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("unable to set resource bare_metal_server `user_scheme` read value: %v", err)
 	}
 
-	vpc2s, err := getBareMetalServerVPC2s(client, d.Id())
+	vpc2s, err := getBareMetalServerVPC2s(ctx, client, d.Id())
 	if err != nil {
 		return diag.Errorf("%s", err.Error())
 	}
@@ -391,7 +385,7 @@ func resourceVultrBareMetalServerUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if d.HasChange("app_id") {
-		log.Printf(`[INFO] Changing bare metal server (%s) application`, d.Id())
+		tflog.Info(ctx, fmt.Sprintf(`Changing bare metal server (%s) application`, d.Id()))
 		_, newVal := d.GetChange("app_id")
 
 		appID := newVal.(int)
@@ -399,7 +393,7 @@ func resourceVultrBareMetalServerUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if d.HasChange("os_id") {
-		log.Printf(`[INFO] Changing bare metal server (%s) operating system`, d.Id())
+		tflog.Info(ctx, fmt.Sprintf(`Changing bare metal server (%s) operating system`, d.Id()))
 		_, newVal := d.GetChange("os_id")
 
 		osID := newVal.(int)
@@ -407,7 +401,7 @@ func resourceVultrBareMetalServerUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if d.HasChange("vpc2_ids") {
-		log.Printf("[INFO] Updating vpc2_ids")
+		tflog.Info(ctx, "Updating vpc2_ids")
 		oldVPC, newVPC := d.GetChange("vpc2_ids")
 
 		var oldIDs []string
@@ -424,9 +418,18 @@ func resourceVultrBareMetalServerUpdate(ctx context.Context, d *schema.ResourceD
 		req.DetachVPC2 = append(req.DetachVPC2, diffSlice(newIDs, oldIDs)...)
 	}
 
-	if d.HasChange("tags") {
-		_, newTags := tfChangeToSlices("tags", d)
-		req.Tags = newTags
+	if d.HasChanges("tags", "tags_all") {
+		tags := resourceTagsWithDefaults(d, meta)
+
+		if ignore := ignoreTags(meta); ignore.enabled() {
+			bms, _, err := client.BareMetalServer.Get(ctx, d.Id())
+			if err != nil {
+				return diag.Errorf("error getting bare metal server %s tags : %v", d.Id(), err)
+			}
+			tags = mergeTags(tags, ignore.matching(bms.Tags))
+		}
+
+		req.Tags = tags
 	}
 
 	if d.HasChange("user_scheme") {
@@ -445,7 +448,7 @@ func resourceVultrBareMetalServerUpdate(ctx context.Context, d *schema.ResourceD
 func resourceVultrBareMetalServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting bare metal server: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting bare metal server: %s", d.Id()))
 
 	if vpcIDs, vpcOK := d.GetOk("vpc2_ids"); vpcOK {
 		detach := &govultr.BareMetalUpdate{}
@@ -458,8 +461,8 @@ func resourceVultrBareMetalServerDelete(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	if err := client.BareMetalServer.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error deleting bare metal server (%s): %v", d.Id(), err)
+	if err := client.BareMetalServer.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error deleting bare metal server (%s): %v", d.Id(), err)
 	}
 
 	return nil
@@ -483,33 +486,29 @@ func bareMetalServerOSCheck(options map[string]bool) (string, error) {
 	return result[0], nil
 }
 
-func waitForBareMetalServerActiveStatus(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf("[INFO] Waiting for bare metal server (%s) to have status of active", d.Id())
-
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"pending"},
-		Target:     []string{"active"},
-		Refresh:    newBareMetalServerStatusStateRefresh(ctx, d, meta),
-		Timeout:    60 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
+func waitForBareMetalServerActiveStatus(ctx context.Context, d *schema.ResourceData, timeout time.Duration, meta interface{}) (*govultr.BareMetalServer, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf("Waiting for bare metal server (%s) to have status of active", d.Id()))
 
-		NotFoundChecks: 60,
+	wait := &stateWait[*govultr.BareMetalServer]{
+		Pending: []string{"pending"},
+		Target:  []string{"active"},
+		Refresh: newBareMetalServerStatusStateRefresh(ctx, d, meta),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
-func newBareMetalServerStatusStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}) retry.StateRefreshFunc { //nolint:lll
+func newBareMetalServerStatusStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}) func() (*govultr.BareMetalServer, string, error) { //nolint:lll
 	client := meta.(*Client).govultrClient()
 
-	return func() (interface{}, string, error) {
+	return func() (*govultr.BareMetalServer, string, error) {
 		bms, _, err := client.BareMetalServer.Get(ctx, d.Id())
 		if err != nil {
 			return nil, "", fmt.Errorf("error retrieving bare metal server %s : %s", d.Id(), err)
 		}
 
-		log.Printf("[INFO] Bare metal server (%s) status: %s", d.Id(), bms.Status)
+		tflog.Info(ctx, fmt.Sprintf("Bare metal server (%s) status: %s", d.Id(), bms.Status))
 		return bms, bms.Status, nil
 	}
 }
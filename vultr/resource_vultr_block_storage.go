@@ -3,13 +3,10 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vultr/govultr/v3"
@@ -74,6 +71,9 @@ func resourceVultrBlockStorage() *schema.Resource {
 				ForceNew:     true,
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
@@ -93,14 +93,14 @@ func resourceVultrBlockStorageCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.SetId(bs.ID)
-	log.Printf("[INFO] Block Storage ID: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Block Storage ID: %s", d.Id()))
 
-	if _, err = waitForBlockAvailable(ctx, d, "active", []string{"pending"}, "status", meta); err != nil {
+	if _, err = waitForBlockAvailable(ctx, d, "active", []string{"pending"}, "status", d.Timeout(schema.TimeoutCreate), meta); err != nil {
 		return diag.Errorf("error while waiting for block %s to be completed: %s", d.Id(), err)
 	}
 
 	if instanceID, ok := d.GetOk("attached_to_instance"); ok {
-		log.Printf("[INFO] Attaching block storage (%s)", d.Id())
+		tflog.Info(ctx, fmt.Sprintf("Attaching block storage (%s)", d.Id()))
 
 		// Wait for the BS state to become active for 30 seconds
 		bsReady := false
@@ -136,14 +136,12 @@ func resourceVultrBlockStorageCreate(ctx context.Context, d *schema.ResourceData
 func resourceVultrBlockStorageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	bs, _, err := client.BlockStorage.Get(ctx, d.Id())
+	bs, resp, err := client.BlockStorage.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid block storage ID") {
-			tflog.Warn(ctx, fmt.Sprintf("Removing block storage (%s) because it is gone", d.Id()))
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting block storage: %v", err)
+		return apiErrorf(resp, err, "error getting block storage: %v", err)
 	}
 
 	if err := d.Set("live", d.Get("live").(bool)); err != nil {
@@ -209,7 +207,7 @@ func resourceVultrBlockStorageUpdate(ctx context.Context, d *schema.ResourceData
 			}
 
 			if bs.AttachedToInstance != "" {
-				log.Printf(`[INFO] Detaching block storage (%s)`, d.Id())
+				tflog.Info(ctx, fmt.Sprintf(`Detaching block storage (%s)`, d.Id()))
 
 				blockReq := &govultr.BlockStorageDetach{Live: govultr.BoolToBoolPtr(d.Get("live").(bool))}
 				err := client.BlockStorage.Detach(ctx, d.Id(), blockReq)
@@ -220,7 +218,7 @@ func resourceVultrBlockStorageUpdate(ctx context.Context, d *schema.ResourceData
 		}
 
 		if newVal.(string) != "" {
-			log.Printf(`[INFO] Attaching block storage (%s)`, d.Id())
+			tflog.Info(ctx, fmt.Sprintf(`Attaching block storage (%s)`, d.Id()))
 			blockReq := &govultr.BlockStorageAttach{
 				InstanceID: newVal.(string),
 				Live:       govultr.BoolToBoolPtr(d.Get("live").(bool)),
@@ -237,43 +235,40 @@ func resourceVultrBlockStorageUpdate(ctx context.Context, d *schema.ResourceData
 func resourceVultrBlockStorageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting block storage: %s", d.Id())
-	if err := client.BlockStorage.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error deleting block storage (%s): %v", d.Id(), err)
+	tflog.Info(ctx, fmt.Sprintf("Deleting block storage: %s", d.Id()))
+	if err := client.BlockStorage.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error deleting block storage (%s): %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func waitForBlockAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for Server (%s) to have %s of %s",
-		d.Id(), attribute, target)
-
-	stateConf := &retry.StateChangeConf{
-		Pending:        pending,
-		Target:         []string{target},
-		Refresh:        newBlockStateRefresh(ctx, d, meta, attribute),
-		Timeout:        60 * time.Minute,
-		Delay:          10 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 60,
+func waitForBlockAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (*govultr.BlockStorage, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for Server (%s) to have %s of %s",
+		d.Id(), attribute, target))
+
+	wait := &stateWait[*govultr.BlockStorage]{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: newBlockStateRefresh(ctx, d, meta, attribute),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
-func newBlockStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) retry.StateRefreshFunc { //nolint:lll
+func newBlockStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) func() (*govultr.BlockStorage, string, error) { //nolint:lll
 	client := meta.(*Client).govultrClient()
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Creating Block")
+	return func() (*govultr.BlockStorage, string, error) {
+		tflog.Info(ctx, "Creating Block")
 		block, _, err := client.BlockStorage.Get(ctx, d.Id())
 		if err != nil {
 			return nil, "", fmt.Errorf("error retrieving block %s : %s", d.Id(), err)
 		}
 
 		if attr == "status" {
-			log.Printf("[INFO] The Block Status is %s", block.Status)
+			tflog.Info(ctx, fmt.Sprintf("The Block Status is %s", block.Status))
 			return block, block.Status, nil
 		} else {
 			return nil, "", nil
@@ -3,11 +3,10 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
 	"regexp"
 	"strconv"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -84,14 +83,14 @@ func resourceVultrContainerRegistryCreate(ctx context.Context, d *schema.Resourc
 		Plan:   d.Get("plan").(string),
 	}
 
-	log.Print("[INFO] Creating container registry")
+	tflog.Info(ctx, "Creating container registry")
 	cr, _, err := client.ContainerRegistry.Create(ctx, crReq)
 	if err != nil {
 		return diag.Errorf("error creating container registry: %v", err)
 	}
 
 	d.SetId(cr.ID)
-	log.Printf("[INFO] Created container registry with ID: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Created container registry with ID: %s", d.Id()))
 
 	return resourceVultrContainerRegistryRead(ctx, d, meta)
 }
@@ -99,14 +98,12 @@ func resourceVultrContainerRegistryCreate(ctx context.Context, d *schema.Resourc
 func resourceVultrContainerRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
 	client := meta.(*Client).govultrClient()
 
-	cr, _, err := client.ContainerRegistry.Get(ctx, d.Id())
+	cr, resp, err := client.ContainerRegistry.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid container registry ID") {
-			log.Printf("[WARN] Container registry (%s) not found and will be removed", d.Id())
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting container registry: %v", err)
+		return apiErrorf(resp, err, "error getting container registry: %v", err)
 	}
 
 	if err := d.Set("name", cr.Name); err != nil {
@@ -135,15 +132,15 @@ func resourceVultrContainerRegistryUpdate(ctx context.Context, d *schema.Resourc
 	client := meta.(*Client).govultrClient()
 
 	vcr := &govultr.ContainerRegistryUpdateReq{}
-	log.Printf("[INFO] Updating container registry: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Updating container registry: %s", d.Id()))
 
 	if d.HasChange("plan") {
-		log.Print("[INFO] Updating `plan`")
+		tflog.Info(ctx, "Updating `plan`")
 		vcr.Plan = govultr.StringToStringPtr(d.Get("plan").(string))
 	}
 
 	if d.HasChange("public") {
-		log.Print("[INFO] Updating `public`")
+		tflog.Info(ctx, "Updating `public`")
 		vcr.Public = govultr.BoolToBoolPtr(d.Get("public").(bool))
 	}
 
@@ -159,12 +156,12 @@ func resourceVultrContainerRegistryUpdate(ctx context.Context, d *schema.Resourc
 
 func resourceVultrContainerRegistryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
 	client := meta.(*Client).govultrClient()
-	log.Printf("[INFO] Deleting container registry: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting container registry: %s", d.Id()))
 
 	err := client.ContainerRegistry.Delete(ctx, d.Id())
 
-	if err != nil {
-		return diag.Errorf("error deleting container registry (%s): %v", d.Id(), err)
+	if err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error deleting container registry (%s): %v", d.Id(), err)
 	}
 
 	return nil
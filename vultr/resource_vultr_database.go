@@ -3,12 +3,10 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vultr/govultr/v3"
@@ -20,6 +18,7 @@ func resourceVultrDatabase() *schema.Resource {
 		ReadContext:   resourceVultrDatabaseRead,
 		UpdateContext: resourceVultrDatabaseUpdate,
 		DeleteContext: resourceVultrDatabaseDelete,
+		CustomizeDiff: customizeDiffDatabaseTagsAll,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -53,6 +52,7 @@ func resourceVultrDatabase() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tags_all": tagsAllSchema(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -106,6 +106,8 @@ func resourceVultrDatabase() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"password_wo":         passwordWOSchema(),
+			"password_wo_version": passwordWOVersionSchema(),
 			// Computed
 			"date_created": {
 				Type:     schema.TypeString,
@@ -212,7 +214,7 @@ func resourceVultrDatabaseCreate(ctx context.Context, d *schema.ResourceData, me
 		Region:                 d.Get("region").(string),
 		Plan:                   d.Get("plan").(string),
 		Label:                  d.Get("label").(string),
-		Tag:                    d.Get("tag").(string),
+		Tag:                    databaseTagWithDefaults(d.Get("tag").(string), defaultTags(meta)),
 		VPCID:                  d.Get("vpc_id").(string),
 		MaintenanceDOW:         d.Get("maintenance_dow").(string),
 		MaintenanceTime:        d.Get("maintenance_time").(string),
@@ -243,17 +245,17 @@ func resourceVultrDatabaseCreate(ctx context.Context, d *schema.ResourceData, me
 		req.MySQLSlowQueryLog = govultr.BoolToBoolPtr(mysqlSlowQueryLog.(bool))
 	}
 
-	log.Printf("[INFO] Creating database")
+	tflog.Info(ctx, "Creating database")
 	database, _, err := client.Database.Create(ctx, req)
 	if err != nil {
 		return diag.Errorf("error creating database: %v", err)
 	}
 
 	d.SetId(database.ID)
-	pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring", "Error"}
-	_, errWait := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", meta)
+	pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring"}
+	_, errWait := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", d.Timeout(schema.TimeoutCreate), meta)
 	if errWait != nil {
-		return diag.Errorf("error while waiting for Managed Database %s to be in an active state : %s", d.Id(), err)
+		return diag.Errorf("error while waiting for Managed Database %s to be in an active state : %s", d.Id(), errWait)
 	}
 
 	// Cluster Time Zone can only be customized after creation
@@ -262,19 +264,28 @@ func resourceVultrDatabaseCreate(ctx context.Context, d *schema.ResourceData, me
 			ClusterTimeZone: clusterTimeZone.(string),
 		}
 
-		log.Printf("[INFO] Updating database default time zone")
+		tflog.Info(ctx, "Updating database default time zone")
 		if _, _, err := client.Database.Update(ctx, d.Id(), req2); err != nil {
 			return diag.Errorf("error updating database: %v", err)
 		}
 	}
 
 	// Default user (vultradmin) password can only be changed after creation
-	if password, passwordOK := d.GetOk("password"); passwordOK && d.Get("database_engine").(string) != "valkey" { //nolint:lll
+	password, passwordOK := d.GetOk("password")
+	if usesWriteOnlyPassword(d) {
+		wo, diags := writeOnlyPassword(d)
+		if diags.HasError() {
+			return diags
+		}
+		password, passwordOK = wo, wo != ""
+	}
+
+	if passwordOK && d.Get("database_engine").(string) != "valkey" {
 		req3 := &govultr.DatabaseUserUpdateReq{
 			Password: password.(string),
 		}
 
-		log.Printf("[INFO] Updating default user password")
+		tflog.Info(ctx, "Updating default user password")
 		if _, _, err := client.Database.UpdateUser(ctx, d.Id(), "vultradmin", req3); err != nil {
 			return diag.Errorf("error updating default user: %v", err)
 		}
@@ -286,14 +297,12 @@ func resourceVultrDatabaseCreate(ctx context.Context, d *schema.ResourceData, me
 func resourceVultrDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	database, _, err := client.Database.Get(ctx, d.Id())
+	database, resp, err := client.Database.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "invalid database ID") {
-			log.Printf("[WARN] Removing database (%s) because it is gone", d.Id())
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting database (%s): %v", d.Id(), err)
+		return apiErrorf(resp, err, "error getting database (%s): %v", d.Id(), err)
 	}
 
 	if err := d.Set("date_created", database.DateCreated); err != nil {
@@ -352,10 +361,15 @@ func resourceVultrDatabaseRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("unable to set resource database `label` read value: %v", err)
 	}
 
-	if err := d.Set("tag", database.Tag); err != nil {
+	tag := dropIgnoredDatabaseTags(database.Tag, ignoreTags(meta))
+	if err := d.Set("tag", stripDatabaseDefaultTags(tag, d.Get("tag").(string), defaultTags(meta))); err != nil {
 		return diag.Errorf("unable to set resource database `tag` read value: %v", err)
 	}
 
+	if err := d.Set("tags_all", splitDatabaseTag(tag)); err != nil {
+		return diag.Errorf("unable to set resource database `tags_all` read value: %v", err)
+	}
+
 	if err := d.Set("dbname", database.DBName); err != nil {
 		return diag.Errorf("unable to set resource database `dbname` read value: %v", err)
 	}
@@ -390,7 +404,12 @@ func resourceVultrDatabaseRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("unable to set resource database `user` read value: %v", err)
 	}
 
-	if err := d.Set("password", database.Password); err != nil {
+	// A write-only password is never stored, even though the API returns it
+	password := database.Password
+	if usesWriteOnlyPassword(d) {
+		password = ""
+	}
+	if err := d.Set("password", password); err != nil {
 		return diag.Errorf("unable to set resource database `password` read value: %v", err)
 	}
 
@@ -464,56 +483,65 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if d.HasChange("region") {
-		log.Printf("[INFO] Updating Region")
+		tflog.Info(ctx, "Updating Region")
 		_, newVal := d.GetChange("region")
 		region := newVal.(string)
 		req.Region = region
 	}
 
 	if d.HasChange("plan") {
-		log.Printf("[INFO] Updating Plan")
+		tflog.Info(ctx, "Updating Plan")
 		_, newVal := d.GetChange("plan")
 		plan := newVal.(string)
 		req.Plan = plan
 	}
 
-	if d.HasChange("tag") {
-		log.Printf("[INFO] Updating Tag")
+	if d.HasChanges("tag", "tags_all") {
+		tflog.Info(ctx, "Updating Tag")
 		_, newVal := d.GetChange("tag")
-		tag := newVal.(string)
+		tag := databaseTagWithDefaults(newVal.(string), defaultTags(meta))
+
+		if ignore := ignoreTags(meta); ignore.enabled() {
+			database, _, err := client.Database.Get(ctx, d.Id())
+			if err != nil {
+				return diag.Errorf("error getting database %s tag : %v", d.Id(), err)
+			}
+			tag = keepIgnoredDatabaseTags(tag, database.Tag, ignore)
+		}
+
 		req.Tag = tag
 	}
 
 	if d.HasChange("vpc_id") {
-		log.Printf("[INFO] Updating VPC ID")
+		tflog.Info(ctx, "Updating VPC ID")
 		_, newVal := d.GetChange("vpc_id")
 		vpc := newVal.(string)
 		req.VPCID = govultr.StringToStringPtr(vpc)
 	}
 
 	if d.HasChange("maintenance_dow") {
-		log.Printf("[INFO] Updating Maintenance DOW")
+		tflog.Info(ctx, "Updating Maintenance DOW")
 		_, newVal := d.GetChange("maintenance_dow")
 		maintenanceDOW := newVal.(string)
 		req.MaintenanceDOW = maintenanceDOW
 	}
 
 	if d.HasChange("maintenance_time") {
-		log.Printf("[INFO] Updating Maintenance Time")
+		tflog.Info(ctx, "Updating Maintenance Time")
 		_, newVal := d.GetChange("maintenance_time")
 		maintenanceTime := newVal.(string)
 		req.MaintenanceTime = maintenanceTime
 	}
 
 	if d.HasChange("cluster_time_zone") {
-		log.Printf("[INFO] Updating Cluster Time Zone")
+		tflog.Info(ctx, "Updating Cluster Time Zone")
 		_, newVal := d.GetChange("cluster_time_zone")
 		clusterTimeZone := newVal.(string)
 		req.ClusterTimeZone = clusterTimeZone
 	}
 
 	if d.HasChange("trusted_ips") {
-		log.Printf("[INFO] Updating Trusted IPs")
+		tflog.Info(ctx, "Updating Trusted IPs")
 		_, newVal := d.GetChange("trusted_ips")
 
 		var newIPs []string
@@ -525,7 +553,7 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if d.HasChange("mysql_sql_modes") {
-		log.Printf("[INFO] Updating MySQL SQL Modes")
+		tflog.Info(ctx, "Updating MySQL SQL Modes")
 		_, newVal := d.GetChange("mysql_sql_modes")
 
 		var newModes []string
@@ -537,28 +565,28 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if d.HasChange("mysql_require_primary_key") {
-		log.Printf("[INFO] Updating MySQL Require Primary Key")
+		tflog.Info(ctx, "Updating MySQL Require Primary Key")
 		_, newVal := d.GetChange("mysql_require_primary_key")
 		mysqlRequirePrimaryKey := newVal.(bool)
 		req.MySQLRequirePrimaryKey = &mysqlRequirePrimaryKey
 	}
 
 	if d.HasChange("mysql_slow_query_log") {
-		log.Printf("[INFO] Updating MySQL Slow Query Log")
+		tflog.Info(ctx, "Updating MySQL Slow Query Log")
 		_, newVal := d.GetChange("mysql_slow_query_log")
 		mysqlSlowQueryLog := newVal.(bool)
 		req.MySQLSlowQueryLog = &mysqlSlowQueryLog
 	}
 
 	if d.HasChange("mysql_long_query_time") {
-		log.Printf("[INFO] Updating MySQL Long Query Time")
+		tflog.Info(ctx, "Updating MySQL Long Query Time")
 		_, newVal := d.GetChange("mysql_long_query_time")
 		mysqlLongQueryTime := newVal.(int)
 		req.MySQLLongQueryTime = mysqlLongQueryTime
 	}
 
 	if d.HasChange("eviction_policy") {
-		log.Printf("[INFO] Updating Eviction Policy")
+		tflog.Info(ctx, "Updating Eviction Policy")
 		_, newVal := d.GetChange("eviction_policy")
 		evictionPolicy := newVal.(string)
 		req.EvictionPolicy = evictionPolicy
@@ -569,8 +597,8 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if d.HasChange("region") || d.HasChange("plan") || d.HasChange("vpc_id") {
-		pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring", "Error"}
-		_, errAvail := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", meta)
+		pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring"}
+		_, errAvail := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", d.Timeout(schema.TimeoutUpdate), meta)
 		if errAvail != nil {
 			return diag.Errorf(
 				"error while waiting for Managed Database %s to be in an active state : %s",
@@ -581,14 +609,21 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	// Updating the default user password requires a separate API call
-	if d.HasChange("password") && d.Get("database_engine").(string) != "valkey" {
+	if (d.HasChange("password") || d.HasChange("password_wo_version")) && d.Get("database_engine").(string) != "valkey" {
 		_, newVal := d.GetChange("password")
 		password := newVal.(string)
+		if d.HasChange("password_wo_version") {
+			wo, diags := writeOnlyPassword(d)
+			if diags.HasError() {
+				return diags
+			}
+			password = wo
+		}
 		reqP := &govultr.DatabaseUserUpdateReq{
 			Password: password,
 		}
 
-		log.Printf("[INFO] Updating default user password")
+		tflog.Info(ctx, "Updating default user password")
 		if _, _, err := client.Database.UpdateUser(ctx, d.Id(), "vultradmin", reqP); err != nil {
 			return diag.Errorf("error updating default user: %v", err)
 		}
@@ -597,7 +632,7 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 	// Version changes have their own API protocol/checks
 	if d.HasChange("database_engine_version") {
 		// Check available versions against input
-		log.Printf("[INFO] Checking available version upgrades")
+		tflog.Info(ctx, "Checking available version upgrades")
 		availableVersions, _, err := client.Database.ListAvailableVersions(ctx, d.Id())
 		if err != nil {
 			return diag.Errorf("error checking available version upgrades %s : %s", d.Id(), err.Error())
@@ -609,7 +644,7 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		// Start version upgrade
-		log.Printf("[INFO] Initiating version upgrade")
+		tflog.Info(ctx, "Initiating version upgrade")
 		req2 := &govultr.DatabaseVersionUpgradeReq{
 			Version: databaseEngineVersion,
 		}
@@ -618,8 +653,8 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		// Wait for running state
-		pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring", "Error"}
-		_, errAvail := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", meta)
+		pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring"}
+		_, errAvail := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", d.Timeout(schema.TimeoutUpdate), meta)
 		if errAvail != nil {
 			return diag.Errorf(
 				"error while waiting for Managed Database %s to be in an active state : %s",
@@ -634,37 +669,35 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 
 func resourceVultrDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
-	log.Printf("[INFO] Deleting database (%s)", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting database (%s)", d.Id()))
 
-	if err := client.Database.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error destroying database %s : %v", d.Id(), err)
+	if err := client.Database.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying database %s : %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func waitForDatabaseAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for Managed Database (%s) to have %s of %s",
-		d.Id(), attribute, target)
+func waitForDatabaseAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (*govultr.Database, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for Managed Database (%s) to have %s of %s",
+		d.Id(), attribute, target))
 
-	stateConf := &retry.StateChangeConf{
-		Pending:        pending,
-		Target:         []string{target},
-		Refresh:        newDatabaseStateRefresh(ctx, d, meta, attribute),
-		Timeout:        60 * time.Minute,
-		Delay:          10 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 60,
+	wait := &stateWait[*govultr.Database]{
+		Pending: pending,
+		Target:  []string{target},
+		Failure: []string{"Error"},
+		Refresh: newDatabaseStateRefresh(ctx, d, meta, attribute),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
-func newDatabaseStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) retry.StateRefreshFunc { //nolint:lll
+func newDatabaseStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) func() (*govultr.Database, string, error) { //nolint:lll
 	client := meta.(*Client).govultrClient()
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Creating Database")
+	return func() (*govultr.Database, string, error) {
+		tflog.Info(ctx, "Creating Database")
 		server, _, err := client.Database.Get(ctx, d.Id())
 
 		if err != nil {
@@ -672,7 +705,7 @@ func newDatabaseStateRefresh(ctx context.Context, d *schema.ResourceData, meta i
 		}
 
 		if attr == "status" {
-			log.Printf("[INFO] The Managed Database Status is %s", server.Status)
+			tflog.Info(ctx, fmt.Sprintf("The Managed Database Status is %s", server.Status))
 			return server, server.Status, nil
 		}
 
@@ -2,8 +2,9 @@ package vultr
 
 import (
 	"context"
-	"log"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -65,7 +66,7 @@ func resourceVultrDatabaseConnectionPoolCreate(ctx context.Context, d *schema.Re
 		Size:     d.Get("size").(int),
 	}
 
-	log.Printf("[INFO] Creating database connection pool")
+	tflog.Info(ctx, "Creating database connection pool")
 	databaseConnectionPool, _, err := client.Database.CreateConnectionPool(ctx, databaseID, req)
 	if err != nil {
 		return diag.Errorf("error creating database connection pool: %v", err)
@@ -81,9 +82,12 @@ func resourceVultrDatabaseConnectionPoolRead(ctx context.Context, d *schema.Reso
 
 	databaseID := d.Get("database_id").(string)
 
-	databaseConnectionPool, _, err := client.Database.GetConnectionPool(ctx, databaseID, d.Id())
+	databaseConnectionPool, resp, err := client.Database.GetConnectionPool(ctx, databaseID, d.Id())
 	if err != nil {
-		return diag.Errorf("error getting database connection pool (%s): %v", d.Id(), err)
+		if removeIfGone(ctx, d, resp, err) {
+			return nil
+		}
+		return apiErrorf(resp, err, "error getting database connection pool (%s): %v", d.Id(), err)
 	}
 
 	if err := d.Set("name", databaseConnectionPool.Name); err != nil {
@@ -117,28 +121,28 @@ func resourceVultrDatabaseConnectionPoolUpdate(ctx context.Context, d *schema.Re
 	req := &govultr.DatabaseConnectionPoolUpdateReq{}
 
 	if d.HasChange("database") {
-		log.Printf("[INFO] Updating Pool Database")
+		tflog.Info(ctx, "Updating Pool Database")
 		_, newVal := d.GetChange("database")
 		database := newVal.(string)
 		req.Database = database
 	}
 
 	if d.HasChange("username") {
-		log.Printf("[INFO] Updating Pool User")
+		tflog.Info(ctx, "Updating Pool User")
 		_, newVal := d.GetChange("username")
 		username := newVal.(string)
 		req.Username = username
 	}
 
 	if d.HasChange("mode") {
-		log.Printf("[INFO] Updating Pool Mode")
+		tflog.Info(ctx, "Updating Pool Mode")
 		_, newVal := d.GetChange("mode")
 		mode := newVal.(string)
 		req.Mode = mode
 	}
 
 	if d.HasChange("size") {
-		log.Printf("[INFO] Updating Pool Size")
+		tflog.Info(ctx, "Updating Pool Size")
 		_, newVal := d.GetChange("size")
 		size := newVal.(int)
 		req.Size = size
@@ -156,12 +160,12 @@ func resourceVultrDatabaseConnectionPoolUpdate(ctx context.Context, d *schema.Re
 
 func resourceVultrDatabaseConnectionPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
 	client := meta.(*Client).govultrClient()
-	log.Printf("[INFO] Deleting database connection pool (%s)", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting database connection pool (%s)", d.Id()))
 
 	databaseID := d.Get("database_id").(string)
 
-	if err := client.Database.DeleteConnectionPool(ctx, databaseID, d.Id()); err != nil {
-		return diag.Errorf("error destroying database connection pool %s : %v", d.Id(), err)
+	if err := client.Database.DeleteConnectionPool(ctx, databaseID, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying database connection pool %s : %v", d.Id(), err)
 	}
 
 	return nil
@@ -2,8 +2,9 @@ package vultr
 
 import (
 	"context"
-	"log"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -44,7 +45,7 @@ func resourceVultrDatabaseDBCreate(ctx context.Context, d *schema.ResourceData,
 		Name: d.Get("name").(string),
 	}
 
-	log.Printf("[INFO] Creating database logical DB")
+	tflog.Info(ctx, "Creating database logical DB")
 	databaseDB, _, err := client.Database.CreateDB(ctx, databaseID, req)
 	if err != nil {
 		return diag.Errorf("error creating database logical DB: %v", err)
@@ -60,9 +61,12 @@ func resourceVultrDatabaseDBRead(ctx context.Context, d *schema.ResourceData, me
 
 	databaseID := d.Get("database_id").(string)
 
-	databaseDB, _, err := client.Database.GetDB(ctx, databaseID, d.Id())
+	databaseDB, resp, err := client.Database.GetDB(ctx, databaseID, d.Id())
 	if err != nil {
-		return diag.Errorf("error getting database logical DB (%s): %v", d.Id(), err)
+		if removeIfGone(ctx, d, resp, err) {
+			return nil
+		}
+		return apiErrorf(resp, err, "error getting database logical DB (%s): %v", d.Id(), err)
 	}
 
 	if err := d.Set("name", databaseDB.Name); err != nil {
@@ -74,12 +78,12 @@ func resourceVultrDatabaseDBRead(ctx context.Context, d *schema.ResourceData, me
 
 func resourceVultrDatabaseDBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
-	log.Printf("[INFO] Deleting database logical DB (%s)", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting database logical DB (%s)", d.Id()))
 
 	databaseID := d.Get("database_id").(string)
 
-	if err := client.Database.DeleteDB(ctx, databaseID, d.Id()); err != nil {
-		return diag.Errorf("error destroying database logical DB %s : %v", d.Id(), err)
+	if err := client.Database.DeleteDB(ctx, databaseID, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying database logical DB %s : %v", d.Id(), err)
 	}
 
 	return nil
@@ -3,9 +3,8 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,7 +17,7 @@ func resourceVultrDatabaseQuota() *schema.Resource {
 		ReadContext:   resourceVultrDatabaseQuotaRead,
 		DeleteContext: resourceVultrDatabaseQuotaDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceVultrDatabaseQuotaImport,
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -65,7 +64,7 @@ func resourceVultrDatabaseQuotaCreate(ctx context.Context, d *schema.ResourceDat
 	user := d.Get("user").(string)
 
 	// Check quota list for duplicate client ID/user combination
-	log.Printf("[INFO] Fetching database quota list")
+	tflog.Info(ctx, "Fetching database quota list")
 	quotas, _, _, err := client.Database.ListQuotas(ctx, databaseID)
 	if err != nil {
 		return diag.Errorf("error creating database quota: %v", err)
@@ -87,7 +86,7 @@ func resourceVultrDatabaseQuotaCreate(ctx context.Context, d *schema.ResourceDat
 		User:              user,
 	}
 
-	log.Printf("[INFO] Creating database quota")
+	tflog.Info(ctx, "Creating database quota")
 	DatabaseQuota, _, err := client.Database.CreateQuota(ctx, databaseID, req)
 	if err != nil {
 		return diag.Errorf("error creating database quota: %v", err)
@@ -102,11 +101,15 @@ func resourceVultrDatabaseQuotaRead(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*Client).govultrClient()
 
 	databaseID := d.Get("database_id").(string)
-	quotaID := strings.Split(d.Id(), "|")
+	clientID := d.Get("client_id").(string)
+	user := d.Get("user").(string)
 
-	DatabaseQuota, _, err := client.Database.GetQuota(ctx, databaseID, quotaID[0], quotaID[1])
+	DatabaseQuota, resp, err := client.Database.GetQuota(ctx, databaseID, clientID, user)
 	if err != nil {
-		return diag.Errorf("error getting database quota (%s): %v", d.Id(), err)
+		if removeIfGone(ctx, d, resp, err) {
+			return nil
+		}
+		return apiErrorf(resp, err, "error getting database quota (%s): %v", d.Id(), err)
 	}
 
 	if err := d.Set("client_id", DatabaseQuota.ClientID); err != nil {
@@ -134,14 +137,41 @@ func resourceVultrDatabaseQuotaRead(ctx context.Context, d *schema.ResourceData,
 
 func resourceVultrDatabaseQuotaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
-	log.Printf("[INFO] Deleting database quota (%s)", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting database quota (%s)", d.Id()))
 
 	databaseID := d.Get("database_id").(string)
-	quotaID := strings.Split(d.Id(), "|")
+	clientID := d.Get("client_id").(string)
+	user := d.Get("user").(string)
 
-	if err := client.Database.DeleteQuota(ctx, databaseID, quotaID[0], quotaID[1]); err != nil {
-		return diag.Errorf("error destroying database quota %s : %v", d.Id(), err)
+	if err := client.Database.DeleteQuota(ctx, databaseID, clientID, user); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying database quota %s : %v", d.Id(), err)
 	}
 
 	return nil
 }
+
+func resourceVultrDatabaseQuotaImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	parts, err := ParseImportID("vultr_database_quota", d.Id())
+	if err != nil {
+		return nil, err
+	}
+	databaseID, clientID, user := parts["database_id"], parts["client_id"], parts["user"]
+
+	if _, _, err := client.Database.GetQuota(ctx, databaseID, clientID, user); err != nil {
+		return nil, fmt.Errorf("database quota %s|%s not found for database %s", clientID, user, databaseID)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", clientID, user))
+	if err := d.Set("database_id", databaseID); err != nil {
+		return nil, fmt.Errorf("unable to set resource database quota `database_id` import value: %v", err)
+	}
+	if err := d.Set("client_id", clientID); err != nil {
+		return nil, fmt.Errorf("unable to set resource database quota `client_id` import value: %v", err)
+	}
+	if err := d.Set("user", user); err != nil {
+		return nil, fmt.Errorf("unable to set resource database quota `user` import value: %v", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -33,6 +33,12 @@ func TestAccVultrDatabaseQuotaBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "user", uName),
 				),
 			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testDatabaseQuotaImportID(name),
+			},
 		},
 	})
 }
@@ -43,10 +49,9 @@ func testAccCheckVultrDatabaseQuotaDestroy(s *terraform.State) error {
 			continue
 		}
 
-		quotaID := strings.Split(rs.Primary.ID, "|")
-
 		client := testAccProvider.Meta().(*Client).govultrClient()
-		_, _, err := client.Database.GetQuota(context.Background(), rs.Primary.Attributes["database_id"], quotaID[0], quotaID[1])
+		_, _, err := client.Database.GetQuota(context.Background(), rs.Primary.Attributes["database_id"],
+			rs.Primary.Attributes["client_id"], rs.Primary.Attributes["user"])
 		if err != nil {
 			if strings.Contains(err.Error(), "Not a valid database quota") || strings.Contains(err.Error(), "Not a valid Database Subscription UUID") {
 				return nil
@@ -70,3 +75,15 @@ func testAccVultrDatabaseQuotaBase(clientID string, user string) string {
 			user = "%s"
 		} `, clientID, user)
 }
+
+func testDatabaseQuotaImportID(r string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", r)
+		}
+
+		return fmt.Sprintf("%s|%s|%s", rs.Primary.Attributes["database_id"],
+			rs.Primary.Attributes["client_id"], rs.Primary.Attributes["user"]), nil
+	}
+}
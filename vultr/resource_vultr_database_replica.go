@@ -3,12 +3,10 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vultr/govultr/v3"
 )
@@ -19,6 +17,7 @@ func resourceVultrDatabaseReplica() *schema.Resource {
 		ReadContext:   resourceVultrDatabaseReplicaRead,
 		UpdateContext: resourceVultrDatabaseReplicaUpdate,
 		DeleteContext: resourceVultrDatabaseReplicaDelete,
+		CustomizeDiff: customizeDiffDatabaseTagsAll,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -34,7 +33,7 @@ func resourceVultrDatabaseReplicaCreate(ctx context.Context, d *schema.ResourceD
 	client := meta.(*Client).govultrClient()
 
 	// Wait for at least one backup on the parent database to be available
-	_, errBackup := waitForParentBackupAvailable(ctx, d, "yes", []string{"yes", "no"}, "latest_backup", meta)
+	_, errBackup := waitForParentBackupAvailable(ctx, d, "yes", []string{"yes", "no"}, "latest_backup", d.Timeout(schema.TimeoutCreate), meta)
 	if errBackup != nil {
 		return diag.Errorf(
 			"error while waiting for parent Managed Database %s to have at least one backup : %s",
@@ -48,7 +47,7 @@ func resourceVultrDatabaseReplicaCreate(ctx context.Context, d *schema.ResourceD
 		Label:  d.Get("label").(string),
 	}
 
-	log.Printf("[INFO] Creating database read replica")
+	tflog.Info(ctx, "Creating database read replica")
 	database, _, err := client.Database.AddReadOnlyReplica(ctx, databaseID, req)
 	if err != nil {
 		return diag.Errorf("error creating database read replica: %v", err)
@@ -56,8 +55,8 @@ func resourceVultrDatabaseReplicaCreate(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(database.ID)
 
-	pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring", "Error"}
-	_, errAvail := waitForDatabaseReplicaAvailable(ctx, d, "Running", pendStatuses, "status", meta)
+	pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring"}
+	_, errAvail := waitForDatabaseReplicaAvailable(ctx, d, "Running", pendStatuses, "status", d.Timeout(schema.TimeoutCreate), meta)
 	if errAvail != nil {
 		return diag.Errorf(
 			"error while waiting for Managed Database read replica %s to be in an active state : %s",
@@ -67,12 +66,12 @@ func resourceVultrDatabaseReplicaCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	// Tags for read replicas can only be changed after creation
-	if tag, tagOK := d.GetOk("tag"); tagOK {
+	if tag := databaseTagWithDefaults(d.Get("tag").(string), defaultTags(meta)); tag != "" {
 		req2 := &govultr.DatabaseUpdateReq{
-			Tag: tag.(string),
+			Tag: tag,
 		}
 
-		log.Printf("[INFO] Updating database read replica tag")
+		tflog.Info(ctx, "Updating database read replica tag")
 		if _, _, err := client.Database.Update(ctx, d.Id(), req2); err != nil {
 			return diag.Errorf("error updating database read replica: %v", err)
 		}
@@ -84,14 +83,12 @@ func resourceVultrDatabaseReplicaCreate(ctx context.Context, d *schema.ResourceD
 func resourceVultrDatabaseReplicaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	database, _, err := client.Database.Get(ctx, d.Id())
+	database, resp, err := client.Database.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "invalid database ID") {
-			log.Printf("[WARN] Removing database read replica (%s) because it is gone", d.Id())
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting database read replica (%s): %v", d.Id(), err)
+		return apiErrorf(resp, err, "error getting database read replica (%s): %v", d.Id(), err)
 	}
 
 	if err := d.Set("date_created", database.DateCreated); err != nil {
@@ -136,10 +133,15 @@ func resourceVultrDatabaseReplicaRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("unable to set resource database read replica `label` read value: %v", err)
 	}
 
-	if err := d.Set("tag", database.Tag); err != nil {
+	tag := dropIgnoredDatabaseTags(database.Tag, ignoreTags(meta))
+	if err := d.Set("tag", stripDatabaseDefaultTags(tag, d.Get("tag").(string), defaultTags(meta))); err != nil {
 		return diag.Errorf("unable to set resource database read replica `tag` read value: %v", err)
 	}
 
+	if err := d.Set("tags_all", splitDatabaseTag(tag)); err != nil {
+		return diag.Errorf("unable to set resource database read replica `tags_all` read value: %v", err)
+	}
+
 	if err := d.Set("database_engine", database.DatabaseEngine); err != nil {
 		return diag.Errorf("unable to set resource database read replica `database_engine` read value: %v", err)
 	}
@@ -254,21 +256,30 @@ func resourceVultrDatabaseReplicaUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if d.HasChange("region") {
-		log.Printf("[INFO] Updating Region")
+		tflog.Info(ctx, "Updating Region")
 		_, newVal := d.GetChange("region")
 		region := newVal.(string)
 		req.Region = region
 	}
 
-	if d.HasChange("tag") {
-		log.Printf("[INFO] Updating Tag")
+	if d.HasChanges("tag", "tags_all") {
+		tflog.Info(ctx, "Updating Tag")
 		_, newVal := d.GetChange("tag")
-		tag := newVal.(string)
+		tag := databaseTagWithDefaults(newVal.(string), defaultTags(meta))
+
+		if ignore := ignoreTags(meta); ignore.enabled() {
+			database, _, err := client.Database.Get(ctx, d.Id())
+			if err != nil {
+				return diag.Errorf("error getting database read replica %s tag : %v", d.Id(), err)
+			}
+			tag = keepIgnoredDatabaseTags(tag, database.Tag, ignore)
+		}
+
 		req.Tag = tag
 	}
 
 	if d.HasChange("vpc_id") {
-		log.Printf("[INFO] Updating VPC ID")
+		tflog.Info(ctx, "Updating VPC ID")
 		_, newVal := d.GetChange("vpc_id")
 		vpc := newVal.(string)
 		req.VPCID = govultr.StringToStringPtr(vpc)
@@ -279,8 +290,8 @@ func resourceVultrDatabaseReplicaUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if d.HasChange("region") || d.HasChange("vpc_id") {
-		pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring", "Error"}
-		_, errAvail := waitForDatabaseReplicaAvailable(ctx, d, "Running", pendStatuses, "status", meta)
+		pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring"}
+		_, errAvail := waitForDatabaseReplicaAvailable(ctx, d, "Running", pendStatuses, "status", d.Timeout(schema.TimeoutUpdate), meta)
 		if errAvail != nil {
 			return diag.Errorf(
 				"error while waiting for Managed Database read replica %s to be in an active state : %s",
@@ -295,37 +306,35 @@ func resourceVultrDatabaseReplicaUpdate(ctx context.Context, d *schema.ResourceD
 
 func resourceVultrDatabaseReplicaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
 	client := meta.(*Client).govultrClient()
-	log.Printf("[INFO] Deleting database read replica (%s)", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting database read replica (%s)", d.Id()))
 
-	if err := client.Database.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error destroying database read replica %s : %v", d.Id(), err)
+	if err := client.Database.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying database read replica %s : %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func waitForDatabaseReplicaAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for Managed Database read replica (%s) to have %s of %s",
-		d.Id(), attribute, target)
+func waitForDatabaseReplicaAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (*govultr.Database, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for Managed Database read replica (%s) to have %s of %s",
+		d.Id(), attribute, target))
 
-	stateConf := &retry.StateChangeConf{
-		Pending:        pending,
-		Target:         []string{target},
-		Refresh:        newDatabaseReplicaStateRefresh(ctx, d, meta, attribute),
-		Timeout:        60 * time.Minute,
-		Delay:          10 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 60,
+	wait := &stateWait[*govultr.Database]{
+		Pending: pending,
+		Target:  []string{target},
+		Failure: []string{"Error"},
+		Refresh: newDatabaseReplicaStateRefresh(ctx, d, meta, attribute),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
-func newDatabaseReplicaStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) retry.StateRefreshFunc { //nolint:lll
+func newDatabaseReplicaStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) func() (*govultr.Database, string, error) { //nolint:lll
 	client := meta.(*Client).govultrClient()
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Creating Database read replica")
+	return func() (*govultr.Database, string, error) {
+		tflog.Info(ctx, "Creating Database read replica")
 		server, _, err := client.Database.Get(ctx, d.Id())
 
 		if err != nil {
@@ -333,7 +342,7 @@ func newDatabaseReplicaStateRefresh(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if attr == "status" {
-			log.Printf("[INFO] The Managed Database read replica Status is %s", server.Status)
+			tflog.Info(ctx, fmt.Sprintf("The Managed Database read replica Status is %s", server.Status))
 			return server, server.Status, nil
 		}
 
@@ -341,28 +350,25 @@ func newDatabaseReplicaStateRefresh(ctx context.Context, d *schema.ResourceData,
 	}
 }
 
-func waitForParentBackupAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for parent Managed Database (%s) to have %s of %s",
-		d.Get("database_id").(string), attribute, target)
+func waitForParentBackupAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (*govultr.Database, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for parent Managed Database (%s) to have %s of %s",
+		d.Get("database_id").(string), attribute, target))
 
-	stateConf := &retry.StateChangeConf{
-		Pending:        pending,
-		Target:         []string{target},
-		Refresh:        parentDatabaseRefresh(ctx, d, meta, attribute),
-		Timeout:        60 * time.Minute,
-		Delay:          10 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 60,
+	wait := &stateWait[*govultr.Database]{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: parentDatabaseRefresh(ctx, d, meta, attribute),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
-func parentDatabaseRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) retry.StateRefreshFunc { //nolint:lll
+func parentDatabaseRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) func() (*govultr.Database, string, error) { //nolint:lll
 	client := meta.(*Client).govultrClient()
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Waiting for parent Managed Database backup status")
+	return func() (*govultr.Database, string, error) {
+		tflog.Info(ctx, "Waiting for parent Managed Database backup status")
 		server, _, err := client.Database.Get(ctx, d.Get("database_id").(string))
 
 		if err != nil {
@@ -370,7 +376,7 @@ func parentDatabaseRefresh(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if attr == "latest_backup" {
-			log.Printf("[INFO] The Managed Database read replica LatestBackup is %s", server.LatestBackup)
+			tflog.Info(ctx, fmt.Sprintf("The Managed Database read replica LatestBackup is %s", server.LatestBackup))
 			if server.LatestBackup == "" {
 				return server, "no", nil
 			}
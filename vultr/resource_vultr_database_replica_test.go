@@ -3,14 +3,93 @@ package vultr
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vultr/govultr/v3"
 )
 
+func TestDatabaseReplicaDefaultTags(t *testing.T) {
+	_, client, api := testFakeProviderAPI(t)
+	ctx := context.Background()
+
+	p := Provider()
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"api_key":      api.APIKey,
+		"api_endpoint": api.URL(),
+		"default_tags": []interface{}{
+			map[string]interface{}{"tags": []interface{}{"team:infra", "env:prod"}},
+		},
+	})); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	database, _, err := client.Database.Create(ctx, &govultr.DatabaseCreateReq{
+		DatabaseEngine:        "pg",
+		DatabaseEngineVersion: "16",
+		Region:                "ewr",
+		Plan:                  "vultr-dbaas-startup-cc-1-55-2",
+		Label:                 "primary",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ty := p.ResourcesMap["vultr_database_replica"].CoreConfigSchema().ImpliedType()
+	config := map[string]cty.Value{
+		"database_id": cty.StringVal(database.ID),
+		"region":      cty.StringVal("ewr"),
+		"label":       cty.StringVal("replica"),
+	}
+
+	assertTags := func(state cty.Value, tag, apiTag string) {
+		t.Helper()
+
+		if got := state.GetAttr("tag").AsString(); got != tag {
+			t.Errorf("expected tag %q, got %q", tag, got)
+		}
+
+		var all []string
+		for _, v := range state.GetAttr("tags_all").AsValueSlice() {
+			all = append(all, v.AsString())
+		}
+		if want := splitDatabaseTag(apiTag); !reflect.DeepEqual(all, want) {
+			t.Errorf("expected tags_all %v, got %v", want, all)
+		}
+
+		replica, _, err := client.Database.Get(ctx, state.GetAttr("id").AsString())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if replica.Tag != apiTag {
+			t.Errorf("expected the replica to be tagged %q, got %q", apiTag, replica.Tag)
+		}
+	}
+
+	state := testApplyResource(t, p, "vultr_database_replica", cty.NullVal(ty), config)
+	assertTags(state, "", "env:prod,team:infra")
+
+	config["tag"] = cty.StringVal("billing")
+	state = testApplyResource(t, p, "vultr_database_replica", state, config)
+	assertTags(state, "billing", "billing,env:prod,team:infra")
+
+	// Once applied, the defaults don't show up as a change
+	plan := testPlanResource(t, p, "vultr_database_replica", state, config)
+	planned, err := msgpack.Unmarshal(plan.PlannedState.MsgPack, ty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !planned.GetAttr("tags_all").RawEquals(state.GetAttr("tags_all")) {
+		t.Errorf("expected no change to tags_all, got %#v", planned.GetAttr("tags_all"))
+	}
+}
+
 func TestAccVultrDatabaseReplicaBasic(t *testing.T) {
 	t.Parallel()
 	pName := acctest.RandomWithPrefix("tf-db-rs")
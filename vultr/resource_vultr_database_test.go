@@ -8,18 +8,20 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccVultrDatabaseBasic(t *testing.T) {
 	t.Parallel()
-	rName := acctest.RandomWithPrefix("tf-db-rs")
+	c := newTestAccCassette(t)
+	rName := c.RandomWithPrefix("tf-db-rs")
 
 	name := "vultr_database.test"
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		CheckDestroy:      testAccCheckVultrDatabaseDestroy,
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:          c.PreCheck,
+		CheckDestroy:      testAccCheckVultrDatabaseDestroyWith(c.Provider()),
+		ProviderFactories: c.ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccVultrDatabaseBase(rName),
@@ -144,23 +146,29 @@ func TestAccVultrDatabaseUpdatePlan(t *testing.T) {
 }
 
 func testAccCheckVultrDatabaseDestroy(s *terraform.State) error {
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "vultr_database" {
-			continue
-		}
+	return testAccCheckVultrDatabaseDestroyWith(testAccProvider)(s)
+}
 
-		client := testAccProvider.Meta().(*Client).govultrClient()
-		_, _, err := client.Database.Get(context.Background(), rs.Primary.ID)
-		if err != nil {
-			if strings.Contains(err.Error(), "Not a valid Database Subscription UUID") {
-				return nil
+func testAccCheckVultrDatabaseDestroyWith(p *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "vultr_database" {
+				continue
+			}
+
+			client := p.Meta().(*Client).govultrClient()
+			_, _, err := client.Database.Get(context.Background(), rs.Primary.ID)
+			if err != nil {
+				if strings.Contains(err.Error(), "Not a valid Database Subscription UUID") {
+					return nil
+				}
+				return fmt.Errorf("error getting database: %s", err)
 			}
-			return fmt.Errorf("error getting database: %s", err)
-		}
 
-		return fmt.Errorf("database %s still exists", rs.Primary.ID)
+			return fmt.Errorf("database %s still exists", rs.Primary.ID)
+		}
+		return nil
 	}
-	return nil
 }
 
 func testAccVultrDatabaseBase(name string) string {
@@ -2,8 +2,9 @@ package vultr
 
 import (
 	"context"
-	"log"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -64,7 +65,7 @@ func resourceVultrDatabaseTopicCreate(ctx context.Context, d *schema.ResourceDat
 		RetentionBytes: d.Get("retention_bytes").(int),
 	}
 
-	log.Printf("[INFO] Creating database topic")
+	tflog.Info(ctx, "Creating database topic")
 	databaseTopic, _, err := client.Database.CreateTopic(ctx, databaseID, req)
 	if err != nil {
 		return diag.Errorf("error creating database topic: %v", err)
@@ -80,9 +81,12 @@ func resourceVultrDatabaseTopicRead(ctx context.Context, d *schema.ResourceData,
 
 	databaseID := d.Get("database_id").(string)
 
-	databaseTopic, _, err := client.Database.GetTopic(ctx, databaseID, d.Id())
+	databaseTopic, resp, err := client.Database.GetTopic(ctx, databaseID, d.Id())
 	if err != nil {
-		return diag.Errorf("error getting database topic (%s): %v", d.Id(), err)
+		if removeIfGone(ctx, d, resp, err) {
+			return nil
+		}
+		return apiErrorf(resp, err, "error getting database topic (%s): %v", d.Id(), err)
 	}
 
 	if err := d.Set("name", databaseTopic.Name); err != nil {
@@ -114,25 +118,25 @@ func resourceVultrDatabaseTopicUpdate(ctx context.Context, d *schema.ResourceDat
 	databaseID := d.Get("database_id").(string)
 
 	req := &govultr.DatabaseTopicUpdateReq{}
-	log.Printf("[INFO] Updating database topic (%s)", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Updating database topic (%s)", d.Id()))
 
 	if d.HasChange("partitions") {
-		log.Print("[INFO] Updating `partitions`")
+		tflog.Info(ctx, "Updating `partitions`")
 		req.Partitions = d.Get("partitions").(int)
 	}
 
 	if d.HasChange("replication") {
-		log.Print("[INFO] Updating `replication`")
+		tflog.Info(ctx, "Updating `replication`")
 		req.Replication = d.Get("replication").(int)
 	}
 
 	if d.HasChange("retention_hours") {
-		log.Print("[INFO] Updating `retention_hours`")
+		tflog.Info(ctx, "Updating `retention_hours`")
 		req.RetentionHours = d.Get("retention_hours").(int)
 	}
 
 	if d.HasChange("retention_bytes") {
-		log.Print("[INFO] Updating `retention_bytes`")
+		tflog.Info(ctx, "Updating `retention_bytes`")
 		req.RetentionBytes = d.Get("retention_bytes").(int)
 	}
 
@@ -145,12 +149,12 @@ func resourceVultrDatabaseTopicUpdate(ctx context.Context, d *schema.ResourceDat
 
 func resourceVultrDatabaseTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
-	log.Printf("[INFO] Deleting database topic (%s)", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting database topic (%s)", d.Id()))
 
 	databaseID := d.Get("database_id").(string)
 
-	if err := client.Database.DeleteTopic(ctx, databaseID, d.Id()); err != nil {
-		return diag.Errorf("error destroying database topic %s : %v", d.Id(), err)
+	if err := client.Database.DeleteTopic(ctx, databaseID, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying database topic %s : %v", d.Id(), err)
 	}
 
 	return nil
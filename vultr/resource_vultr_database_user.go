@@ -2,8 +2,9 @@ package vultr
 
 import (
 	"context"
-	"log"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -37,6 +38,8 @@ func resourceVultrDatabaseUser() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"password_wo":         passwordWOSchema(),
+			"password_wo_version": passwordWOVersionSchema(),
 			"encryption": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -81,7 +84,15 @@ func resourceVultrDatabaseUserCreate(ctx context.Context, d *schema.ResourceData
 		Permission: d.Get("permission").(string),
 	}
 
-	log.Printf("[INFO] Creating database user")
+	if usesWriteOnlyPassword(d) {
+		password, diags := writeOnlyPassword(d)
+		if diags.HasError() {
+			return diags
+		}
+		req.Password = password
+	}
+
+	tflog.Info(ctx, "Creating database user")
 	databaseUser, _, err := client.Database.CreateUser(ctx, databaseID, req)
 	if err != nil {
 		return diag.Errorf("error creating database user: %v", err)
@@ -104,16 +115,24 @@ func resourceVultrDatabaseUserRead(ctx context.Context, d *schema.ResourceData,
 
 	databaseID := d.Get("database_id").(string)
 
-	databaseUser, _, err := client.Database.GetUser(ctx, databaseID, d.Id())
+	databaseUser, resp, err := client.Database.GetUser(ctx, databaseID, d.Id())
 	if err != nil {
-		return diag.Errorf("error getting database user (%s): %v", d.Id(), err)
+		if removeIfGone(ctx, d, resp, err) {
+			return nil
+		}
+		return apiErrorf(resp, err, "error getting database user (%s): %v", d.Id(), err)
 	}
 
 	if err := d.Set("username", databaseUser.Username); err != nil {
 		return diag.Errorf("unable to set resource database user `username` read value: %v", err)
 	}
 
-	if err := d.Set("password", databaseUser.Password); err != nil {
+	// A write-only password is never stored, even though the API returns it
+	password := databaseUser.Password
+	if usesWriteOnlyPassword(d) {
+		password = ""
+	}
+	if err := d.Set("password", password); err != nil {
 		return diag.Errorf("unable to set resource database user `password` read value: %v", err)
 	}
 
@@ -157,7 +176,7 @@ func resourceVultrDatabaseUserUpdate(ctx context.Context, d *schema.ResourceData
 	databaseID := d.Get("database_id").(string)
 
 	if d.HasChange("password") {
-		log.Printf("[INFO] Updating Password")
+		tflog.Info(ctx, "Updating Password")
 		_, newVal := d.GetChange("password")
 		password := newVal.(string)
 		req := &govultr.DatabaseUserUpdateReq{
@@ -168,6 +187,20 @@ func resourceVultrDatabaseUserUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	if d.HasChange("password_wo_version") {
+		tflog.Info(ctx, "Updating Password")
+		password, diags := writeOnlyPassword(d)
+		if diags.HasError() {
+			return diags
+		}
+		req := &govultr.DatabaseUserUpdateReq{
+			Password: password,
+		}
+		if _, _, err := client.Database.UpdateUser(ctx, databaseID, d.Id(), req); err != nil {
+			return diag.Errorf("error updating database user %s : %s", d.Id(), err.Error())
+		}
+	}
+
 	if d.HasChange("access_control") {
 		_, accessControl := d.GetChange("access_control")
 		if err := updateUserACL(ctx, client, databaseID, d, accessControl); err != nil {
@@ -176,7 +209,7 @@ func resourceVultrDatabaseUserUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if d.HasChange("permission") {
-		log.Printf("[INFO] Updating Permission")
+		tflog.Info(ctx, "Updating Permission")
 		_, newVal := d.GetChange("permission")
 		permission := newVal.(string)
 		req2 := &govultr.DatabaseUserACLReq{
@@ -192,12 +225,12 @@ func resourceVultrDatabaseUserUpdate(ctx context.Context, d *schema.ResourceData
 
 func resourceVultrDatabaseUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
-	log.Printf("[INFO] Deleting database user (%s)", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting database user (%s)", d.Id()))
 
 	databaseID := d.Get("database_id").(string)
 
-	if err := client.Database.DeleteUser(ctx, databaseID, d.Id()); err != nil {
-		return diag.Errorf("error destroying database user %s : %v", d.Id(), err)
+	if err := client.Database.DeleteUser(ctx, databaseID, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying database user %s : %v", d.Id(), err)
 	}
 
 	return nil
@@ -230,7 +263,7 @@ func updateUserACL(ctx context.Context, client *govultr.Client, databaseID strin
 		}
 		req.ACLKeys = &aclKeys
 
-		log.Printf("[INFO] Updating user access control")
+		tflog.Info(ctx, "Updating user access control")
 		if _, _, err := client.Database.UpdateUserACL(ctx, databaseID, d.Id(), req); err != nil {
 			return err
 		}
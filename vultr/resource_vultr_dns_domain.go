@@ -3,8 +3,6 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -61,7 +59,7 @@ func resourceVultrDNSDomainCreate(ctx context.Context, d *schema.ResourceData, m
 		domainReq.IP = ip.(string)
 	}
 
-	log.Print("[INFO] Creating domain")
+	tflog.Info(ctx, "Creating domain")
 
 	domain, _, err := client.Domain.Create(ctx, domainReq)
 	if err != nil {
@@ -76,14 +74,12 @@ func resourceVultrDNSDomainCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceVultrDNSDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	domain, _, err := client.Domain.Get(ctx, d.Id())
+	domain, resp, err := client.Domain.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid domain") {
-			tflog.Warn(ctx, fmt.Sprintf("Removing domain (%s) because it is gone", d.Id()))
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting domains : %v", err)
+		return apiErrorf(resp, err, "error getting domains : %v", err)
 	}
 
 	if err := d.Set("domain", domain.Domain); err != nil {
@@ -102,7 +98,7 @@ func resourceVultrDNSDomainRead(ctx context.Context, d *schema.ResourceData, met
 func resourceVultrDNSDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Updated domain (%s)", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Updated domain (%s)", d.Id()))
 	if err := client.Domain.Update(ctx, d.Id(), d.Get("dns_sec").(string)); err != nil {
 		return diag.Errorf("error updating domain %s: %v", d.Id(), err)
 	}
@@ -113,9 +109,9 @@ func resourceVultrDNSDomainUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceVultrDNSDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting domain (%s)", d.Id())
-	if err := client.Domain.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error destroying domain %s: %v", d.Id(), err)
+	tflog.Info(ctx, fmt.Sprintf("Deleting domain (%s)", d.Id()))
+	if err := client.Domain.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying domain %s: %v", d.Id(), err)
 	}
 
 	return nil
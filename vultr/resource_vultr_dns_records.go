@@ -3,9 +3,8 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -69,7 +68,7 @@ func resourceVultrDNSRecordCreate(ctx context.Context, d *schema.ResourceData, m
 		Priority: &p,
 	}
 
-	log.Print("[INFO] Creating DNS record")
+	tflog.Info(ctx, "Creating DNS record")
 	record, _, err := client.DomainRecord.Create(ctx, d.Get("domain").(string), recordReq)
 	if err != nil {
 		return diag.Errorf("error creating DNS record : %v", err)
@@ -81,11 +80,12 @@ func resourceVultrDNSRecordCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceVultrDNSRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	record, _, err := client.DomainRecord.Get(ctx, d.Get("domain").(string), d.Id())
+	record, resp, err := client.DomainRecord.Get(ctx, d.Get("domain").(string), d.Id())
 	if err != nil {
-		log.Printf("[WARN] DNS Record %s not found", d.Id())
-		d.SetId("")
-		return nil
+		if removeIfGone(ctx, d, resp, err) {
+			return nil
+		}
+		return apiErrorf(resp, err, "error getting dns record %s : %v", d.Id(), err)
 	}
 
 	if err := d.Set("domain", d.Get("domain").(string)); err != nil {
@@ -111,7 +111,7 @@ func resourceVultrDNSRecordRead(ctx context.Context, d *schema.ResourceData, met
 func resourceVultrDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Updating DNS record: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Updating DNS record: %s", d.Id()))
 
 	p := d.Get("priority").(int)
 	record := &govultr.DomainRecordReq{
@@ -131,9 +131,9 @@ func resourceVultrDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceVultrDNSRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting DNS record: %s", d.Id())
-	if err := client.DomainRecord.Delete(ctx, d.Get("domain").(string), d.Id()); err != nil {
-		return diag.Errorf("error deleting dns record %s : %v", d.Id(), err)
+	tflog.Info(ctx, fmt.Sprintf("Deleting DNS record: %s", d.Id()))
+	if err := client.DomainRecord.Delete(ctx, d.Get("domain").(string), d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error deleting dns record %s : %v", d.Id(), err)
 	}
 
 	return nil
@@ -142,12 +142,11 @@ func resourceVultrDNSRecordDelete(ctx context.Context, d *schema.ResourceData, m
 func resourceVultrDNSRecordImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) { //nolint:lll
 	client := meta.(*Client).govultrClient()
 
-	importID := d.Id()
-	commaIdx := strings.IndexByte(importID, ',')
-	if commaIdx == -1 {
-		return nil, fmt.Errorf(`invalid import format, expected "domain,resourceID"`)
+	parts, err := ParseImportID("vultr_dns_record", d.Id())
+	if err != nil {
+		return nil, err
 	}
-	domain, recordID := importID[:commaIdx], importID[commaIdx+1:]
+	domain, recordID := parts["domain"], parts["id"]
 
 	record, _, err := client.DomainRecord.Get(ctx, domain, recordID)
 	if err != nil {
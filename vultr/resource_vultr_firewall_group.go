@@ -2,9 +2,9 @@ package vultr
 
 import (
 	"context"
-	"log"
-	"strings"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vultr/govultr/v3"
@@ -53,7 +53,7 @@ func resourceVultrFirewallGroupCreate(ctx context.Context, d *schema.ResourceDat
 	client := meta.(*Client).govultrClient()
 
 	fwReq := &govultr.FirewallGroupReq{Description: d.Get("description").(string)}
-	log.Printf("[INFO] Creating new firewall group")
+	tflog.Info(ctx, "Creating new firewall group")
 	fwGroup, _, err := client.FirewallGroup.Create(ctx, fwReq)
 	if err != nil {
 		return diag.Errorf("error creating firewall group: %v", err)
@@ -67,15 +67,13 @@ func resourceVultrFirewallGroupCreate(ctx context.Context, d *schema.ResourceDat
 func resourceVultrFirewallGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	group, _, err := client.FirewallGroup.Get(ctx, d.Id())
+	group, resp, err := client.FirewallGroup.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "\"status\":404") {
-			log.Printf("[WARN] Removing firewall group (%s) because it is gone", d.Id())
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
 
-		return diag.Errorf("error getting firewall group %s : %v", d.Id(), err)
+		return apiErrorf(resp, err, "error getting firewall group %s : %v", d.Id(), err)
 	}
 
 	if err := d.Set("description", group.Description); err != nil {
@@ -102,7 +100,7 @@ func resourceVultrFirewallGroupRead(ctx context.Context, d *schema.ResourceData,
 func resourceVultrFirewallGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Updating firewall group: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Updating firewall group: %s", d.Id()))
 
 	fwReq := &govultr.FirewallGroupReq{Description: d.Get("description").(string)}
 	if err := client.FirewallGroup.Update(ctx, d.Id(), fwReq); err != nil {
@@ -115,10 +113,10 @@ func resourceVultrFirewallGroupUpdate(ctx context.Context, d *schema.ResourceDat
 func resourceVultrFirewallGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting firewall group: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting firewall group: %s", d.Id()))
 
-	if err := client.FirewallGroup.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error destroying firewall group %s: %v", d.Id(), err)
+	if err := client.FirewallGroup.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying firewall group %s: %v", d.Id(), err)
 	}
 	return nil
 }
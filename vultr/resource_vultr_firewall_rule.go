@@ -3,7 +3,6 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
@@ -77,7 +76,7 @@ func resourceVultrFirewallRule() *schema.Resource {
 func resourceVultrFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Creating new firewall rule")
+	tflog.Info(ctx, "Creating new firewall rule")
 
 	protocol := d.Get("protocol").(string)
 
@@ -111,21 +110,12 @@ func resourceVultrFirewallRuleRead(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*Client).govultrClient()
 
 	ruleID, _ := strconv.Atoi(d.Id())
-	fw, _, err := client.FirewallRule.Get(ctx, d.Get("firewall_group_id").(string), ruleID)
+	fw, resp, err := client.FirewallRule.Get(ctx, d.Get("firewall_group_id").(string), ruleID)
 	if err != nil {
-		if strings.Contains(err.Error(), "Firewall rule ID not found") {
-			tflog.Warn(ctx,
-				fmt.Sprintf(
-					"Removing firewall rule ID (%s) in group (%s) because it is gone",
-					d.Id(),
-					d.Get("firewall_group_id"),
-				),
-			)
-
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting firewall rule %s: %v", d.Get("firewall_group_id").(string), err)
+		return apiErrorf(resp, err, "error getting firewall rule %s: %v", d.Get("firewall_group_id").(string), err)
 	}
 
 	if err := d.Set("ip_type", fw.IPType); err != nil {
@@ -161,9 +151,9 @@ func resourceVultrFirewallRuleDelete(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("error converting firewall rule ID")
 	}
 
-	log.Printf("[INFO] Delete firewall rule : %s", d.Id())
-	if err := client.FirewallRule.Delete(ctx, d.Get("firewall_group_id").(string), id); err != nil {
-		return diag.Errorf("error destroying firewall rule %s: %v", d.Id(), err)
+	tflog.Info(ctx, fmt.Sprintf("Delete firewall rule : %s", d.Id()))
+	if err := client.FirewallRule.Delete(ctx, d.Get("firewall_group_id").(string), id); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying firewall rule %s: %v", d.Id(), err)
 	}
 	return nil
 }
@@ -171,13 +161,11 @@ func resourceVultrFirewallRuleDelete(ctx context.Context, d *schema.ResourceData
 func resourceVultrFirewallRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) { //nolint:lll
 	client := meta.(*Client).govultrClient()
 
-	importID := d.Id()
-	commaIdx := strings.IndexByte(importID, ',')
-
-	if commaIdx == -1 {
-		return nil, fmt.Errorf(`invalid import format, expected "firewallGroupID,firewallRuleID"`)
+	parts, err := ParseImportID("vultr_firewall_rule", d.Id())
+	if err != nil {
+		return nil, err
 	}
-	fwGroup, ruleID := importID[:commaIdx], importID[commaIdx+1:]
+	fwGroup, ruleID := parts["firewall_group_id"], parts["id"]
 
 	rule, _ := strconv.Atoi(ruleID)
 	fw, _, err := client.FirewallRule.Get(ctx, fwGroup, rule)
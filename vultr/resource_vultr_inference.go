@@ -2,9 +2,9 @@ package vultr
 
 import (
 	"context"
-	"log"
-	"strings"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vultr/govultr/v3"
@@ -55,7 +55,7 @@ func resourceVultrInferenceCreate(ctx context.Context, d *schema.ResourceData, m
 		Label: d.Get("label").(string),
 	}
 
-	log.Printf("[INFO] Creating inference subscription")
+	tflog.Info(ctx, "Creating inference subscription")
 	inferenceSub, _, err := client.Inference.Create(ctx, req)
 	if err != nil {
 		return diag.Errorf("error creating inference subscription: %v", err)
@@ -69,14 +69,12 @@ func resourceVultrInferenceCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceVultrInferenceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	inferenceSub, _, err := client.Inference.Get(ctx, d.Id())
+	inferenceSub, resp, err := client.Inference.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "invalid inference ID") {
-			log.Printf("[WARN] Removing inference subscription (%s) because it is gone", d.Id())
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting inference subscription (%s): %v", d.Id(), err)
+		return apiErrorf(resp, err, "error getting inference subscription (%s): %v", d.Id(), err)
 	}
 
 	if err := d.Set("date_created", inferenceSub.DateCreated); err != nil {
@@ -117,10 +115,10 @@ func resourceVultrInferenceUpdate(ctx context.Context, d *schema.ResourceData, m
 
 func resourceVultrInferenceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
-	log.Printf("[INFO] Deleting inference subscription (%s)", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting inference subscription (%s)", d.Id()))
 
-	if err := client.Inference.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error destroying inference subscription %s : %v", d.Id(), err)
+	if err := client.Inference.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying inference subscription %s : %v", d.Id(), err)
 	}
 
 	return nil
@@ -3,12 +3,15 @@ package vultr
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,6 +24,7 @@ func resourceVultrInstance() *schema.Resource {
 		ReadContext:   resourceVultrInstanceRead,
 		UpdateContext: resourceVultrInstanceUpdate,
 		DeleteContext: resourceVultrInstanceDelete,
+		CustomizeDiff: customdiff.All(customizeDiffTagsAll, customizeDiffInstanceReinstall),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -54,13 +58,11 @@ func resourceVultrInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 				Optional: true,
-				ForceNew: true,
 			},
 			"os_id": {
 				Type:     schema.TypeInt,
 				Computed: true,
 				Optional: true,
-				ForceNew: true,
 			},
 			"script_id": {
 				Type:     schema.TypeString,
@@ -111,7 +113,6 @@ Will not do anything unless enable_ipv6 is also true.`,
 				Type:     schema.TypeString,
 				Computed: true,
 				Optional: true,
-				ForceNew: true,
 			},
 			"activation_email": {
 				Type:     schema.TypeBool,
@@ -124,12 +125,19 @@ Will not do anything unless enable_ipv6 is also true.`,
 			},
 			"hostname": {
 				Type:     schema.TypeString,
-				ForceNew: true,
 				Computed: true,
 				Optional: true,
 				Description: `The hostname of the instance. Updating the
-hostname will cause a force new. This behavior is in place to prevent accidental reinstalls. Issuing an update to the
-hostname on UI or API issues a reinstall of the OS.`,
+hostname will cause a force new, unless reinstall_on_change is set. This behavior is in place to prevent accidental
+reinstalls. Issuing an update to the hostname on UI or API issues a reinstall of the OS.`,
+			},
+			"reinstall_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `Reinstall the instance in place, instead of replacing it, when user_data, os_id,
+image_id or hostname change. The reinstall erases the instance's disk. The hostname can't change in the
+same apply as os_id or image_id.`,
 			},
 			"tags": {
 				Type:     schema.TypeSet,
@@ -137,6 +145,7 @@ hostname on UI or API issues a reinstall of the OS.`,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Default:  nil,
 			},
+			"tags_all": tagsAllSchema(),
 			"reserved_ip_id": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -198,6 +207,44 @@ hostname on UI or API issues a reinstall of the OS.`,
 				ForceNew: true,
 				Default:  "root",
 			},
+			"restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Description: `A backup or snapshot to restore into the instance. The restore runs once the instance is
+created, and again when the block, or restore_trigger, changes.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+							ExactlyOneOf: []string{"restore.0.backup_id", "restore.0.snapshot_id"},
+						},
+						"snapshot_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+							ExactlyOneOf: []string{"restore.0.backup_id", "restore.0.snapshot_id"},
+						},
+					},
+				},
+			},
+			"restore_trigger": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"restore"},
+				Description:  "Any value; changing it restores the backup or snapshot in the restore block again.",
+			},
+			"power_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"running", "stopped"}, false),
+				Description: `Whether the instance should be running or stopped. Plan, ISO, OS and image changes,
+reinstalls and restores briefly boot a stopped instance, which is halted again once they finish. When it is not
+set, the instance is otherwise left in whatever state it is in.`,
+			},
 			"app_variables": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -283,6 +330,10 @@ hostname on UI or API issues a reinstall of the OS.`,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Computed: true,
 			},
+			"last_restored_source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Hour),
@@ -301,9 +352,9 @@ func resourceVultrInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 	backups := d.Get("backups").(string)
 	backupSchedule, backupsScheduleOk := d.GetOk("backups_schedule")
 
-	if backups == "enabled" && !backupsScheduleOk {
-		return diag.Errorf("Backups are set to enabled please provide a backups_schedule")
-	} else if backups == "disabled" && backupsScheduleOk {
+	// Backups can be enabled without a schedule, leaving the schedule to the
+	// API's default or to a vultr_instance_backup_schedule
+	if backups == "disabled" && backupsScheduleOk {
 		return diag.Errorf("Backups are set to disabled please remove backups_schedule")
 	}
 
@@ -362,11 +413,7 @@ func resourceVultrInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("error occurred while getting your intended os type")
 	}
 
-	if tagsIDs, tagsOK := d.GetOk("tags"); tagsOK {
-		for _, v := range tagsIDs.(*schema.Set).List() {
-			req.Tags = append(req.Tags, v.(string))
-		}
-	}
+	req.Tags = resourceTagsWithDefaults(d, meta)
 
 	if vpcIDs, vpcOK := d.GetOk("vpc_ids"); vpcOK {
 		for _, v := range vpcIDs.(*schema.Set).List() {
@@ -386,7 +433,7 @@ func resourceVultrInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	log.Printf("[INFO] Creating server")
+	tflog.Info(ctx, "Creating server")
 	var instance *govultr.Instance = nil
 
 	// allow for retries on creation to handle retryable platform errors
@@ -414,36 +461,50 @@ func resourceVultrInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("unable to set resource instance `default_password` create value: %v", err)
 	}
 
-	_, err = waitForServerAvailable(ctx, d, "active", []string{"pending", "installing"}, "status", meta)
+	_, err = waitForServerAvailable(ctx, d, "active", []string{"pending", "installing"}, "status", d.Timeout(schema.TimeoutCreate), meta)
 	if err != nil {
 		return diag.Errorf("error while waiting for Server %s to be completed: %s", d.Id(), err)
 	}
 
-	if _, err = waitForServerAvailable(ctx, d, "running", []string{"stopped"}, "power_status", meta); err != nil {
+	if _, err = waitForServerAvailable(ctx, d, "running", []string{"stopped"}, "power_status", d.Timeout(schema.TimeoutCreate), meta); err != nil {
 		return diag.Errorf("error while waiting for Server %s to be in a active state : %s", d.Id(), err)
 	}
 
-	if backups == "enabled" {
-		backupReq := generateBackupSchedule(backupSchedule)
+	// The restore resets the backup schedule, so it runs first
+	if restore, restoreOK := d.GetOk("restore"); restoreOK {
+		restoreReq := restore.([]interface{})[0].(map[string]interface{})
+		source, err := restoreInstance(ctx, d, restoreReq, false, d.Timeout(schema.TimeoutCreate), meta)
+		if err != nil {
+			return diag.Errorf("error restoring instance %s : %v", d.Id(), err)
+		}
+
+		if err := d.Set("last_restored_source", source); err != nil {
+			return diag.Errorf("unable to set resource instance `last_restored_source` create value: %v", err)
+		}
+	}
+
+	if backupReq := generateBackupSchedule(backupSchedule); backups == "enabled" && backupReq != nil {
 		if _, err := client.Instance.SetBackupSchedule(context.Background(), instance.ID, backupReq); err != nil {
 			return diag.Errorf("error setting backup schedule: %v", err)
 		}
 	}
 
+	if err := setInstancePowerState(ctx, d, "", d.Timeout(schema.TimeoutCreate), meta); err != nil {
+		return diag.Errorf("error setting power state of instance %s : %v", d.Id(), err)
+	}
+
 	return resourceVultrInstanceRead(ctx, d, meta)
 }
 
 func resourceVultrInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	instance, _, err := client.Instance.Get(ctx, d.Id())
+	instance, resp, err := client.Instance.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "invalid instance ID") {
-			log.Printf("[WARN] Removing instance (%s) because it is gone", d.Id())
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting instance (%s): %v", d.Id(), err)
+		return apiErrorf(resp, err, "error getting instance (%s): %v", d.Id(), err)
 	}
 
 	if err := d.Set("os", instance.Os); err != nil {
@@ -479,6 +540,11 @@ func resourceVultrInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	if err := d.Set("power_status", instance.PowerStatus); err != nil {
 		return diag.Errorf("unable to set resource instance `power_status` read value: %v", err)
 	}
+	if instance.PowerStatus == "running" || instance.PowerStatus == "stopped" {
+		if err := d.Set("power_state", instance.PowerStatus); err != nil {
+			return diag.Errorf("unable to set resource instance `power_state` read value: %v", err)
+		}
+	}
 	if err := d.Set("server_status", instance.ServerStatus); err != nil {
 		return diag.Errorf("unable to set resource instance `server_status` read value: %v", err)
 	}
@@ -497,7 +563,7 @@ func resourceVultrInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	if err := d.Set("v6_network_size", instance.V6NetworkSize); err != nil {
 		return diag.Errorf("unable to set resource instance `v6_network_size` read value: %v", err)
 	}
-	if err := d.Set("tags", instance.Tags); err != nil {
+	if err := setResourceTags(d, instance.Tags, meta); err != nil {
 		return diag.Errorf("unable to set resource instance `tags` read value: %v", err)
 	}
 	if err := d.Set("firewall_group_id", instance.FirewallGroupID); err != nil {
@@ -553,34 +619,107 @@ func resourceVultrInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	vpcs, err := getVPCs(client, d.Id())
+	vpcs, err := getVPCs(ctx, client, d.Id())
 	if err != nil {
 		return diag.Errorf("%s", err.Error())
 	}
 
-	vpc2s, err := getVPC2s(client, d.Id())
+	vpc2s, err := getVPC2s(ctx, client, d.Id())
 	if err != nil {
 		return diag.Errorf("%s", err.Error())
 	}
 
-	if _, vpcUpdate := d.GetOk("vpc_ids"); vpcUpdate {
+	var diags diag.Diagnostics
+	if managed, vpcUpdate := d.GetOk("vpc_ids"); vpcUpdate {
+		diags = append(diags, unmanagedVPCWarning(d, "vpc_ids", managed.(*schema.Set), vpcs)...)
 		if err := d.Set("vpc_ids", vpcs); err != nil {
 			return diag.Errorf("unable to set resource instance `vpc_ids` read value: %v", err)
 		}
 	}
 
-	if _, vpc2Update := d.GetOk("vpc2_ids"); vpc2Update {
+	if managed, vpc2Update := d.GetOk("vpc2_ids"); vpc2Update {
+		diags = append(diags, unmanagedVPCWarning(d, "vpc2_ids", managed.(*schema.Set), vpc2s)...)
 		if err := d.Set("vpc2_ids", vpc2s); err != nil {
 			return diag.Errorf("unable to set resource instance `vpc2_ids` read value: %v", err)
 		}
 	}
 
-	return nil
+	return diags
+}
+
+// unmanagedVPCWarning warns about networks attached to the instance outside
+// of an inline VPC set, such as by a vultr_instance_vpc_attachment, which the
+// next apply would detach
+func unmanagedVPCWarning(d *schema.ResourceData, key string, managed *schema.Set, attached []string) diag.Diagnostics {
+	var unmanaged []string
+	for _, id := range attached {
+		if !managed.Contains(id) {
+			unmanaged = append(unmanaged, id)
+		}
+	}
+
+	if len(unmanaged) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Instance %s has networks attached outside of %s", d.Id(), key),
+		Detail: fmt.Sprintf("%s are attached to the instance but not in %s, so they will be detached. Networks "+
+			"should be attached either with %s or with vultr_instance_vpc_attachment resources, not both.",
+			strings.Join(unmanaged, ", "), key, key),
+		AttributePath: cty.GetAttrPath(key),
+	}}
 }
 
 func resourceVultrInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
+	// The backup arguments are checked before anything changes, since a
+	// restore or reinstall can't be undone
+	bs, bsOK := d.GetOk("backups_schedule")
+	_, newBackupValue := d.GetChange("backups")
+	if d.HasChange("backups") && newBackupValue.(string) == "disabled" && bsOK {
+		return diag.Errorf("Backups are being set to disabled please remove backups_schedule")
+	}
+
+	// Plan, ISO and OS changes, reinstalls and restores boot a stopped
+	// instance, so it is halted again afterwards and the waits for it to
+	// finish booting are skipped
+	var stopped bool
+	if d.HasChanges(instancePowerCycleKeys...) {
+		instance, _, err := client.Instance.Get(ctx, d.Id())
+		if err != nil {
+			return diag.Errorf("error getting instance %s : %v", d.Id(), err)
+		}
+		stopped = instance.PowerStatus == "stopped"
+	}
+
+	// A reinstall or restore resets the backup schedule. Without a
+	// backups_schedule block, the current schedule, such as one from a
+	// vultr_instance_backup_schedule, is read first to be applied again.
+	restore, restoreOK := d.GetOk("restore")
+	restored := restoreOK && d.HasChanges("restore", "restore_trigger")
+	reinstalled := d.HasChanges(instanceReinstallKeys...)
+	schedule := generateBackupSchedule(bs)
+	inline := d.GetRawConfig().GetAttr("backups_schedule")
+	if (restored || reinstalled) && (inline.IsNull() || inline.LengthInt() == 0) {
+		current, _, err := client.Instance.GetBackupSchedule(ctx, d.Id())
+		if err != nil {
+			return diag.Errorf("error getting backup schedule of instance %s : %v", d.Id(), err)
+		}
+
+		schedule = nil
+		if current.Enabled != nil && *current.Enabled && current.Type != "" {
+			schedule = &govultr.BackupScheduleReq{
+				Type: current.Type,
+				Hour: govultr.IntToIntPtr(current.Hour),
+				Dom:  current.Dom,
+				Dow:  govultr.IntToIntPtr(current.Dow),
+			}
+		}
+	}
+
 	req := &govultr.InstanceUpdateReq{
 		Label:           d.Get("label").(string),
 		FirewallGroupID: d.Get("firewall_group_id").(string),
@@ -588,35 +727,42 @@ func resourceVultrInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if d.HasChange("plan") {
-		log.Printf("[INFO] Updating Plan")
+		tflog.Info(ctx, "Updating Plan")
 		_, newVal := d.GetChange("plan")
 		plan := newVal.(string)
 		req.Plan = plan
 	}
 
+	if d.HasChange("user_data") {
+		tflog.Info(ctx, "Updating user data")
+		req.UserData = base64.StdEncoding.EncodeToString([]byte(d.Get("user_data").(string)))
+	}
+
+	// Changing the OS or image reinstalls the instance
+	if d.HasChange("os_id") {
+		tflog.Info(ctx, "Updating OS")
+		req.OsID = d.Get("os_id").(int)
+	}
+
+	if d.HasChange("image_id") {
+		tflog.Info(ctx, "Updating image")
+		req.ImageID = d.Get("image_id").(string)
+	}
+
 	if d.HasChange("ddos_protection") {
-		log.Printf("[INFO] Updating DDOS Protection")
+		tflog.Info(ctx, "Updating DDOS Protection")
 		_, newVal := d.GetChange("ddos_protection")
 		ddos := newVal.(bool)
 		req.DDOSProtection = &ddos
 	}
 
-	bs, bsOK := d.GetOk("backups_schedule")
-	_, newBackupValue := d.GetChange("backups")
 	if d.HasChange("backups") {
-		log.Printf("[INFO] Updating Backups")
-		backups := newBackupValue.(string)
-		req.Backups = backups
-
-		if backups == "disabled" && bsOK {
-			return diag.Errorf("Backups are being set to disabled please remove backups_schedule")
-		} else if backups == "enabled" && !bsOK {
-			return diag.Errorf("Backups are being set to enabled please add backups_schedule")
-		}
+		tflog.Info(ctx, "Updating Backups")
+		req.Backups = newBackupValue.(string)
 	}
 
 	if d.HasChange("vpc_ids") {
-		log.Printf("[INFO] Updating vpc_ids")
+		tflog.Info(ctx, "Updating vpc_ids")
 		oldVPC, newVPC := d.GetChange("vpc_ids")
 
 		var oldIDs []string
@@ -634,7 +780,7 @@ func resourceVultrInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if d.HasChange("vpc2_ids") {
-		log.Printf("[INFO] Updating vpc2_ids")
+		tflog.Info(ctx, "Updating vpc2_ids")
 		oldVPC, newVPC := d.GetChange("vpc2_ids")
 
 		var oldIDs []string
@@ -651,9 +797,18 @@ func resourceVultrInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		req.DetachVPC2 = append(req.DetachVPC2, diffSlice(newIDs, oldIDs)...)
 	}
 
-	if d.HasChange("tags") {
-		_, newTags := tfChangeToSlices("tags", d)
-		req.Tags = newTags
+	if d.HasChanges("tags", "tags_all") {
+		tags := resourceTagsWithDefaults(d, meta)
+
+		if ignore := ignoreTags(meta); ignore.enabled() {
+			instance, _, err := client.Instance.Get(ctx, d.Id())
+			if err != nil {
+				return diag.Errorf("error getting instance %s tags : %v", d.Id(), err)
+			}
+			tags = mergeTags(tags, ignore.matching(instance.Tags))
+		}
+
+		req.Tags = tags
 	}
 
 	if _, _, err := client.Instance.Update(ctx, d.Id(), req); err != nil {
@@ -661,7 +816,7 @@ func resourceVultrInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if d.HasChange("iso_id") {
-		log.Printf("[INFO] Updating ISO")
+		tflog.Info(ctx, "Updating ISO")
 
 		_, newISOId := d.GetChange("iso_id")
 		if newISOId == "" {
@@ -675,16 +830,33 @@ func resourceVultrInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if newBackupValue.(string) == "enabled" && !bsOK {
-		return diag.Errorf("Backups are being set to enabled please add backups_schedule")
+	if restored {
+		restoreReq := restore.([]interface{})[0].(map[string]interface{})
+		source, err := restoreInstance(ctx, d, restoreReq, stopped, d.Timeout(schema.TimeoutUpdate), meta)
+		if err != nil {
+			return diag.Errorf("error restoring instance %s : %v", d.Id(), err)
+		}
+
+		if err := d.Set("last_restored_source", source); err != nil {
+			return diag.Errorf("unable to set resource instance `last_restored_source` update value: %v", err)
+		}
+	}
+
+	if reinstalled {
+		if err := reinstallInstance(ctx, d, stopped, meta); err != nil {
+			return diag.Errorf("error reinstalling instance %s : %v", d.Id(), err)
+		}
 	}
 
 	// If we are disabling backups we don't do anything.
 	// On the read that gets called we will nil out backups_schedule.
-	if newBackupValue.(string) != "disabled" && d.HasChange("backups_schedule") {
-		schedule := generateBackupSchedule(bs)
-		if _, err := client.Instance.SetBackupSchedule(ctx, d.Id(), schedule); err != nil {
-			return diag.Errorf("error setting backup for %s : %v", d.Id(), err)
+	// A reinstall or restore resets the schedule, so it is applied again
+	// after one.
+	if newBackupValue.(string) != "disabled" && (d.HasChange("backups_schedule") || reinstalled || restored) {
+		if schedule != nil {
+			if _, err := client.Instance.SetBackupSchedule(ctx, d.Id(), schedule); err != nil {
+				return diag.Errorf("error setting backup for %s : %v", d.Id(), err)
+			}
 		}
 	}
 
@@ -692,24 +864,35 @@ func resourceVultrInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	// This will wait until the plan has been updated before going to the read call
 	if d.HasChange("plan") {
 		oldP, newP := d.GetChange("plan")
-		if _, err := waitForPlanUpgrade(ctx, d, newP.(string), []string{oldP.(string)}, meta); err != nil {
+		if _, err := waitForPlanUpgrade(ctx, d, newP.(string), []string{oldP.(string)}, d.Timeout(schema.TimeoutUpdate), meta); err != nil {
 			return diag.Errorf("error while waiting for instance %s to have updated plan : %s", d.Id(), err)
 		}
 	}
 
 	if d.HasChange("user_scheme") {
-		log.Printf("[INFO] Updating UserScheme")
+		tflog.Info(ctx, "Updating UserScheme")
 		_, newVal := d.GetChange("user_scheme")
 		uScheme := newVal.(string)
 		req.UserScheme = uScheme
 	}
 
+	// Plan changes and ISO attachments reboot the instance, so the power
+	// state is checked after every update rather than only when it changes.
+	// An instance that one of them booted is halted again.
+	fallback := ""
+	if stopped {
+		fallback = "stopped"
+	}
+	if err := setInstancePowerState(ctx, d, fallback, d.Timeout(schema.TimeoutUpdate), meta); err != nil {
+		return diag.Errorf("error setting power state of instance %s : %v", d.Id(), err)
+	}
+
 	return resourceVultrInstanceRead(ctx, d, meta)
 }
 
 func resourceVultrInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
-	log.Printf("[INFO] Deleting instance (%s)", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting instance (%s)", d.Id()))
 
 	if vpcIDs, vpcOK := d.GetOk("vpc_ids"); vpcOK {
 		detach := &govultr.InstanceUpdateReq{}
@@ -739,13 +922,165 @@ func resourceVultrInstanceDelete(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if err := client.Instance.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error destroying instance %s : %v", d.Id(), err)
+	if err := client.Instance.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying instance %s : %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// instanceReinstallKeys are the arguments that can only be changed by
+// reinstalling the instance
+var instanceReinstallKeys = []string{"user_data", "os_id", "image_id", "hostname"}
+
+// instancePowerCycleKeys are the arguments whose changes can boot a stopped
+// instance
+var instancePowerCycleKeys = []string{
+	"plan", "iso_id", "restore", "restore_trigger", "user_data", "os_id", "image_id", "hostname",
+}
+
+// customizeDiffInstanceReinstall replaces the instance when an argument that
+// needs a reinstall changes, unless reinstall_on_change is set
+func customizeDiffInstanceReinstall(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.Get("reinstall_on_change").(bool) {
+		// An OS or image change installs the current hostname, and a
+		// reinstall keeps the current OS, so one rebuild can't apply both
+		if d.HasChange("hostname") && (d.HasChange("os_id") || d.HasChange("image_id")) {
+			return fmt.Errorf("hostname can't change in the same reinstall as os_id or image_id, apply them separately")
+		}
+		return nil
+	}
+
+	for _, key := range instanceReinstallKeys {
+		if d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+// reinstallInstance rebuilds the instance once to apply a new OS, image,
+// hostname or user data. An OS or image change is already being installed by
+// the instance update, which also set the user data, so it is only waited
+// for; otherwise the instance is reinstalled.
+func reinstallInstance(ctx context.Context, d *schema.ResourceData, stopped bool, meta interface{}) error {
+	client := meta.(*Client).govultrClient()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if !d.HasChanges("os_id", "image_id") {
+		tflog.Info(ctx, fmt.Sprintf("Reinstalling instance (%s)", d.Id()))
+
+		req := &govultr.ReinstallReq{}
+		if d.HasChange("hostname") {
+			req.Hostname = d.Get("hostname").(string)
+		}
+
+		if _, _, err := client.Instance.Reinstall(ctx, d.Id(), req); err != nil {
+			return err
+		}
+	}
+
+	return waitForInstanceInstalled(ctx, d, stopped, timeout, meta)
+}
+
+// restoreInstance restores a backup or snapshot into the instance, waits for
+// it to boot again and returns the source it was restored from
+func restoreInstance(
+	ctx context.Context, d *schema.ResourceData, restore map[string]interface{}, stopped bool, timeout time.Duration,
+	meta interface{},
+) (string, error) {
+	client := meta.(*Client).govultrClient()
+
+	req := &govultr.RestoreReq{
+		BackupID:   restore["backup_id"].(string),
+		SnapshotID: restore["snapshot_id"].(string),
+	}
+
+	source := "backup_id:" + req.BackupID
+	if req.SnapshotID != "" {
+		source = "snapshot_id:" + req.SnapshotID
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Restoring instance (%s) from %s", d.Id(), source))
+	if _, err := client.Instance.Restore(ctx, d.Id(), req); err != nil {
+		return "", err
+	}
+
+	// The instance stays active while the restore runs, so it is only done
+	// once the server status is ok again
+	if err := waitForInstanceInstalled(ctx, d, stopped, timeout, meta); err != nil {
+		return "", err
+	}
+
+	return source, nil
+}
+
+// instanceRebuildStartTimeout bounds the wait for a reinstall or restore to
+// show up in the status of the instance. It is a variable so that tests
+// against the fake API, where rebuilds can finish before they are seen,
+// don't have to wait.
+var instanceRebuildStartTimeout = 2 * time.Minute
+
+// waitForInstanceInstalled waits for a reinstall to start and finish and,
+// unless the instance was stopped, for it to boot. The API keeps reporting
+// the instance as it was for a while after accepting a rebuild, so waiting
+// for it to finish straight away could return before it has started.
+func waitForInstanceInstalled(
+	ctx context.Context, d *schema.ResourceData, stopped bool, timeout time.Duration, meta interface{},
+) error {
+	if err := waitForInstanceRebuildStart(ctx, d, meta); err != nil {
+		return err
+	}
+
+	pending := []string{"pending", "installing"}
+	if _, err := waitForServerAvailable(ctx, d, "active", pending, "status", timeout, meta); err != nil {
+		return err
+	}
+
+	if stopped {
+		return nil
+	}
+
+	pending = []string{"none", "locked", "installingbooting"}
+	_, err := waitForServerAvailable(ctx, d, "ok", pending, "server_status", timeout, meta)
+	return err
+}
+
+// waitForInstanceRebuildStart waits for the status or server status of the
+// instance to leave active and ok. A rebuild that isn't seen within
+// instanceRebuildStartTimeout is taken to have already finished.
+func waitForInstanceRebuildStart(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Info(ctx, fmt.Sprintf("Waiting for instance (%s) to start rebuilding", d.Id()))
+
+	refresh := newServerStateRefresh(ctx, d, meta, "server_status", "rebuilding")
+	wait := &stateWait[*govultr.Instance]{
+		Pending: []string{"ok"},
+		Target:  []string{"pending", "installing", "installingbooting", "locked", "none"},
+		Refresh: func() (*govultr.Instance, string, error) {
+			instance, state, err := refresh()
+			if err == nil && instance.Status != "active" {
+				state = instance.Status
+			}
+			return instance, state, err
+		},
+		Timeout: instanceRebuildStartTimeout,
+	}
+
+	_, err := wait.wait(ctx)
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		tflog.Info(ctx, fmt.Sprintf("Instance (%s) wasn't seen rebuilding, it may have already finished", d.Id()))
+		return nil
+	}
+	return err
+}
+
 func optionCheck(options map[string]bool) (string, error) {
 	var result []string
 	for k, v := range options {
@@ -766,79 +1101,126 @@ func optionCheck(options map[string]bool) (string, error) {
 	return result[0], nil
 }
 
-func waitForServerAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for Server (%s) to have %s of %s",
-		d.Id(), attribute, target)
+func waitForServerAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (*govultr.Instance, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for Server (%s) to have %s of %s",
+		d.Id(), attribute, target))
 
-	stateConf := &retry.StateChangeConf{
-		Pending:        pending,
-		Target:         []string{target},
-		Refresh:        newServerStateRefresh(ctx, d, meta, attribute),
-		Timeout:        60 * time.Minute,
-		Delay:          10 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 60,
+	wait := &stateWait[*govultr.Instance]{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: newServerStateRefresh(ctx, d, meta, attribute, target),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
-func newServerStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) retry.StateRefreshFunc { //nolint:lll
+func newServerStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr, target string) func() (*govultr.Instance, string, error) { //nolint:lll
 	client := meta.(*Client).govultrClient()
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Creating Server")
+	return func() (*govultr.Instance, string, error) {
+		tflog.Info(ctx, fmt.Sprintf("Waiting for instance (%s) %s to become %s", d.Id(), attr, target))
 		server, _, err := client.Instance.Get(ctx, d.Id())
 		if err != nil {
 			return nil, "", fmt.Errorf("error retrieving Server %s : %s", d.Id(), err)
 		}
 
 		if attr == "status" {
-			log.Printf("[INFO] The Server Status is %s", server.Status)
+			tflog.Info(ctx, fmt.Sprintf("The Server Status is %s", server.Status))
 			return server, server.Status, nil
 		} else if attr == "power_status" {
-			log.Printf("[INFO] The Server Power Status is %s", server.PowerStatus)
+			tflog.Info(ctx, fmt.Sprintf("The Server Power Status is %s", server.PowerStatus))
 			return server, server.PowerStatus, nil
+		} else if attr == "server_status" {
+			tflog.Info(ctx, fmt.Sprintf("The Server server_status is %s", server.ServerStatus))
+			return server, server.ServerStatus, nil
 		} else {
 			return nil, "", nil
 		}
 	}
 }
 
-func waitForPlanUpgrade(ctx context.Context, d *schema.ResourceData, target string, pending []string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for instance (%s) to have plan of %s",
-		d.Id(), target)
+// setInstancePowerState starts or halts the instance to match power_state,
+// or fallback when power_state isn't set, and waits for its power_status to
+// follow. The config is read rather than the state so that an instance whose
+// power state isn't managed is only started or halted to undo an update
+// that booted it.
+func setInstancePowerState(
+	ctx context.Context, d *schema.ResourceData, fallback string, timeout time.Duration, meta interface{},
+) error {
+	target := fallback
+	if config := d.GetRawConfig().GetAttr("power_state"); !config.IsNull() && config.IsKnown() {
+		target = config.AsString()
+	}
+	if target == "" {
+		return nil
+	}
+
+	client := meta.(*Client).govultrClient()
+	instance, _, err := client.Instance.Get(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("error getting instance %s : %v", d.Id(), err)
+	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:        pending,
-		Target:         []string{target},
-		Refresh:        newInstancePlanRefresh(ctx, d, meta),
-		Timeout:        60 * time.Minute,
-		Delay:          10 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 60,
+	if instance.PowerStatus == target {
+		return nil
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	var pending string
+	switch target {
+	case "running":
+		tflog.Info(ctx, fmt.Sprintf("Starting instance (%s)", d.Id()))
+		pending = "stopped"
+		err = client.Instance.Start(ctx, d.Id())
+	case "stopped":
+		tflog.Info(ctx, fmt.Sprintf("Halting instance (%s)", d.Id()))
+		pending = "running"
+		err = client.Instance.Halt(ctx, d.Id())
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForServerAvailable(ctx, d, target, []string{pending}, "power_status", timeout, meta)
+	return err
 }
 
-func newInstancePlanRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}) retry.StateRefreshFunc {
+func waitForPlanUpgrade(ctx context.Context, d *schema.ResourceData, target string, pending []string, timeout time.Duration, meta interface{}) (*govultr.Instance, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for instance (%s) to have plan of %s",
+		d.Id(), target))
+
+	wait := &stateWait[*govultr.Instance]{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: newInstancePlanRefresh(ctx, d, meta),
+		Timeout: timeout,
+	}
+
+	return wait.wait(ctx)
+}
+
+func newInstancePlanRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}) func() (*govultr.Instance, string, error) {
 	client := meta.(*Client).govultrClient()
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Upgrading instance")
+	return func() (*govultr.Instance, string, error) {
+		tflog.Info(ctx, "Upgrading instance")
 		instance, _, err := client.Instance.Get(ctx, d.Id())
 		if err != nil {
 			return nil, "", fmt.Errorf("error retrieving instance %s : %s", d.Id(), err)
 		}
 
-		log.Printf("[INFO] The instances plan is %s", instance.Plan)
+		tflog.Info(ctx, fmt.Sprintf("The instances plan is %s", instance.Plan))
 		return instance, instance.Plan, nil
 	}
 }
 
+// generateBackupSchedule returns the request for a backups_schedule block, or
+// nil when the block is empty
 func generateBackupSchedule(backup interface{}) *govultr.BackupScheduleReq {
-	k := backup.([]interface{})
+	k, ok := backup.([]interface{})
+	if !ok || len(k) == 0 || k[0] == nil {
+		return nil
+	}
 
 	config := k[0].(map[string]interface{})
 	return &govultr.BackupScheduleReq{
@@ -849,10 +1231,11 @@ func generateBackupSchedule(backup interface{}) *govultr.BackupScheduleReq {
 	}
 }
 
+// backupStatus returns the backups value for whether a backup schedule is
+// enabled, which the API leaves out when backups have never been enabled
 func backupStatus(status *bool) string {
-	if *status {
+	if status != nil && *status {
 		return "enabled"
-	} else {
-		return "disabled"
 	}
+	return "disabled"
 }
@@ -0,0 +1,232 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+func resourceVultrInstanceBackupSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrInstanceBackupScheduleCreate,
+		ReadContext:   resourceVultrInstanceBackupScheduleRead,
+		UpdateContext: resourceVultrInstanceBackupScheduleUpdate,
+		DeleteContext: resourceVultrInstanceBackupScheduleDelete,
+		CustomizeDiff: customizeDiffBackupSchedule,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						"daily",
+						"weekly",
+						"monthly",
+						"daily_alt_even",
+						"daily_alt_odd",
+					},
+					false,
+				),
+			},
+			"hour": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 23),
+				Description:  "The hour of the day, in UTC, to run backups at.",
+			},
+			"dow": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 7),
+				Description:  "The day of the week, from 1 for Sunday to 7 for Saturday, to run weekly backups on.",
+			},
+			"dom": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 28),
+				Description:  "The day of the month to run monthly backups on.",
+			},
+			"next_scheduled_time_utc": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVultrInstanceBackupScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	instanceID := d.Get("instance_id").(string)
+
+	backup, resp, err := client.Instance.GetBackupSchedule(ctx, instanceID)
+	if err != nil {
+		return apiErrorf(resp, err, "error getting backup schedule of instance %s : %v", instanceID, err)
+	}
+
+	// A schedule can only be set once backups are enabled
+	if backupStatus(backup.Enabled) == "disabled" {
+		tflog.Info(ctx, fmt.Sprintf("Enabling backups for instance (%s)", instanceID))
+		if _, _, err := client.Instance.Update(ctx, instanceID, &govultr.InstanceUpdateReq{Backups: "enabled"}); err != nil {
+			return diag.Errorf("error enabling backups for instance %s : %v", instanceID, err)
+		}
+	}
+
+	d.SetId(instanceID)
+
+	if err := setInstanceBackupSchedule(ctx, d, meta); err != nil {
+		return diag.Errorf("error setting backup schedule of instance %s : %v", d.Id(), err)
+	}
+
+	return resourceVultrInstanceBackupScheduleRead(ctx, d, meta)
+}
+
+func resourceVultrInstanceBackupScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	backup, resp, err := client.Instance.GetBackupSchedule(ctx, d.Id())
+	if err != nil {
+		if removeIfGone(ctx, d, resp, err) {
+			return nil
+		}
+		return apiErrorf(resp, err, "error getting backup schedule of instance %s : %v", d.Id(), err)
+	}
+
+	if backupStatus(backup.Enabled) == "disabled" {
+		tflog.Warn(ctx, fmt.Sprintf("Removing backup schedule (%s) from state because backups are disabled", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("instance_id", d.Id()); err != nil {
+		return diag.Errorf("unable to set resource instance_backup_schedule `instance_id` read value: %v", err)
+	}
+	if err := d.Set("type", backup.Type); err != nil {
+		return diag.Errorf("unable to set resource instance_backup_schedule `type` read value: %v", err)
+	}
+	if err := d.Set("hour", backup.Hour); err != nil {
+		return diag.Errorf("unable to set resource instance_backup_schedule `hour` read value: %v", err)
+	}
+	if err := d.Set("dow", backup.Dow); err != nil {
+		return diag.Errorf("unable to set resource instance_backup_schedule `dow` read value: %v", err)
+	}
+	if err := d.Set("dom", backup.Dom); err != nil {
+		return diag.Errorf("unable to set resource instance_backup_schedule `dom` read value: %v", err)
+	}
+	if err := d.Set("next_scheduled_time_utc", backup.NextScheduleTimeUTC); err != nil {
+		return diag.Errorf("unable to set resource instance_backup_schedule `next_scheduled_time_utc` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrInstanceBackupScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	if err := setInstanceBackupSchedule(ctx, d, meta); err != nil {
+		return diag.Errorf("error setting backup schedule of instance %s : %v", d.Id(), err)
+	}
+
+	return resourceVultrInstanceBackupScheduleRead(ctx, d, meta)
+}
+
+func resourceVultrInstanceBackupScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+	tflog.Info(ctx, fmt.Sprintf("Disabling backups for instance (%s)", d.Id()))
+
+	if _, _, err := client.Instance.Update(ctx, d.Id(), &govultr.InstanceUpdateReq{Backups: "disabled"}); err != nil && !isNotFound(nil, err) { //nolint:lll
+		return apiErrorf(nil, err, "error disabling backups for instance %s : %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// setInstanceBackupSchedule sends the configured schedule. The day of the
+// week and month are only sent for the types that use them, as their
+// computed values may be left over from a previous type.
+func setInstanceBackupSchedule(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).govultrClient()
+
+	req := &govultr.BackupScheduleReq{
+		Type: d.Get("type").(string),
+	}
+
+	config := d.GetRawConfig()
+	if hour := config.GetAttr("hour"); !hour.IsNull() {
+		req.Hour = govultr.IntToIntPtr(d.Get("hour").(int))
+	}
+
+	switch req.Type {
+	case "weekly":
+		req.Dow = govultr.IntToIntPtr(d.Get("dow").(int))
+	case "monthly":
+		req.Dom = d.Get("dom").(int)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Setting backup schedule of instance (%s)", d.Id()))
+	_, err := client.Instance.SetBackupSchedule(ctx, d.Id(), req)
+	return err
+}
+
+// customizeDiffBackupSchedule checks that the day of the week is set for
+// weekly backups, and the day of the month for monthly backups, and that
+// neither is set for a type that would ignore it
+func customizeDiffBackupSchedule(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.GetAttr("type").IsKnown() {
+		return nil
+	}
+
+	if err := validateBackupSchedule(
+		config.GetAttr("type").AsString(),
+		!config.GetAttr("dow").IsNull(),
+		!config.GetAttr("dom").IsNull(),
+	); err != nil {
+		return err
+	}
+
+	// The days left out of the config are reset by the API when the type
+	// changes, so their prior values can't be kept in the plan
+	if d.Id() != "" && d.HasChange("type") {
+		for _, key := range []string{"dow", "dom"} {
+			if config.GetAttr(key).IsNull() {
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateBackupSchedule(scheduleType string, dowSet, domSet bool) error {
+	switch {
+	case scheduleType == "weekly" && !dowSet:
+		return fmt.Errorf("dow is required for a weekly backup schedule")
+	case scheduleType != "weekly" && dowSet:
+		return fmt.Errorf("dow can only be set for a weekly backup schedule, not %s", scheduleType)
+	case scheduleType == "monthly" && !domSet:
+		return fmt.Errorf("dom is required for a monthly backup schedule")
+	case scheduleType != "monthly" && domSet:
+		return fmt.Errorf("dom can only be set for a monthly backup schedule, not %s", scheduleType)
+	}
+
+	return nil
+}
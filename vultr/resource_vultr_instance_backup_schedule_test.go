@@ -0,0 +1,238 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vultr/govultr/v3"
+)
+
+func TestAccVultrInstanceBackupSchedule(t *testing.T) {
+	t.Parallel()
+	rName := acctest.RandomWithPrefix("tf-vps-backups")
+
+	name := "vultr_instance_backup_schedule.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrInstanceBackupScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrInstanceBackupScheduleBase(rName, `dow = 2`, "weekly"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "instance_id", "vultr_instance.test", "id"),
+					resource.TestCheckResourceAttr(name, "type", "weekly"),
+					resource.TestCheckResourceAttr(name, "dow", "2"),
+					resource.TestCheckResourceAttr(name, "hour", "3"),
+					resource.TestCheckResourceAttrSet(name, "next_scheduled_time_utc"),
+				),
+			},
+			{
+				Config: testAccVultrInstanceBackupScheduleBase(rName, `dom = 15`, "monthly"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "monthly"),
+					resource.TestCheckResourceAttr(name, "dom", "15"),
+					resource.TestCheckResourceAttr("vultr_instance.test", "backups", "enabled"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"next_scheduled_time_utc"},
+			},
+		},
+	})
+}
+
+func TestInstanceBackupSchedule(t *testing.T) {
+	p, client := testFakeProvider(t)
+	ctx := context.Background()
+	r := p.ResourcesMap["vultr_instance_backup_schedule"]
+
+	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{
+		Region: "ewr", Plan: "vc2-1c-1gb", OsID: 1743,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config := map[string]cty.Value{
+		"instance_id": cty.StringVal(instance.ID),
+		"type":        cty.StringVal("weekly"),
+		"hour":        cty.NumberIntVal(3),
+		"dow":         cty.NumberIntVal(2),
+	}
+	prior := cty.NullVal(r.CoreConfigSchema().ImpliedType())
+	state := testApplyResource(t, p, "vultr_instance_backup_schedule", prior, config)
+
+	if got := state.GetAttr("id").AsString(); got != instance.ID {
+		t.Errorf("expected ID %q, got %q", instance.ID, got)
+	}
+	if got := state.GetAttr("next_scheduled_time_utc").AsString(); got == "" {
+		t.Error("expected the next scheduled time")
+	}
+
+	backup, _, err := client.Instance.GetBackupSchedule(ctx, instance.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if backupStatus(backup.Enabled) != "enabled" || backup.Type != "weekly" || backup.Hour != 3 || backup.Dow != 2 {
+		t.Errorf("expected backups enabled weekly on day 2 at 3, got %+v", backup)
+	}
+
+	// Switching to a monthly schedule leaves the day of the week out
+	config["type"] = cty.StringVal("monthly")
+	config["dom"] = cty.NumberIntVal(15)
+	delete(config, "dow")
+	plan := testPlanResource(t, p, "vultr_instance_backup_schedule", state, config)
+	planned, err := msgpack.Unmarshal(plan.PlannedState.MsgPack, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if planned.GetAttr("dow").IsKnown() {
+		t.Errorf("expected dow to be unknown when the type changes, got %#v", planned.GetAttr("dow"))
+	}
+	testApplyResource(t, p, "vultr_instance_backup_schedule", state, config)
+
+	backup, _, err = client.Instance.GetBackupSchedule(ctx, instance.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if backup.Type != "monthly" || backup.Dom != 15 || backup.Dow != 0 {
+		t.Errorf("expected backups monthly on day 15, got %+v", backup)
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	d.SetId(instance.ID)
+	if diags := r.DeleteContext(ctx, d, p.Meta()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// Once backups are disabled the schedule is gone
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	d.SetId(instance.ID)
+	if diags := r.ReadContext(ctx, d, p.Meta()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Error("expected the schedule to be removed from state once backups are disabled")
+	}
+}
+
+func TestInstanceBackupScheduleWithInstanceBackups(t *testing.T) {
+	p, client := testFakeProvider(t)
+	ctx := context.Background()
+	ty := p.ResourcesMap["vultr_instance"].CoreConfigSchema().ImpliedType()
+
+	// Backups are enabled on the instance and the schedule is left to the
+	// schedule resource
+	config := map[string]cty.Value{
+		"region":  cty.StringVal("ewr"),
+		"plan":    cty.StringVal("vc2-1c-1gb"),
+		"os_id":   cty.NumberIntVal(1743),
+		"backups": cty.StringVal("enabled"),
+	}
+	state := testApplyResource(t, p, "vultr_instance", cty.NullVal(ty), config)
+	id := state.GetAttr("id").AsString()
+
+	scheduleType := p.ResourcesMap["vultr_instance_backup_schedule"].CoreConfigSchema().ImpliedType()
+	testApplyResource(t, p, "vultr_instance_backup_schedule", cty.NullVal(scheduleType), map[string]cty.Value{
+		"instance_id": cty.StringVal(id),
+		"type":        cty.StringVal("daily"),
+		"hour":        cty.NumberIntVal(5),
+	})
+
+	// Updating the instance keeps the schedule it doesn't manage
+	config["label"] = cty.StringVal("backups-elsewhere")
+	state = testApplyResource(t, p, "vultr_instance", state, config)
+	if got := state.GetAttr("backups").AsString(); got != "enabled" {
+		t.Errorf("expected backups to stay enabled, got %s", got)
+	}
+
+	backup, _, err := client.Instance.GetBackupSchedule(ctx, id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if backupStatus(backup.Enabled) != "enabled" || backup.Type != "daily" || backup.Hour != 5 {
+		t.Errorf("expected the daily schedule at 5 to be kept, got %+v", backup)
+	}
+}
+
+func TestValidateBackupSchedule(t *testing.T) {
+	tests := []struct {
+		scheduleType   string
+		dowSet, domSet bool
+		wantErr        bool
+	}{
+		{scheduleType: "daily"},
+		{scheduleType: "daily_alt_odd"},
+		{scheduleType: "weekly", dowSet: true},
+		{scheduleType: "monthly", domSet: true},
+		{scheduleType: "weekly", wantErr: true},
+		{scheduleType: "monthly", wantErr: true},
+		{scheduleType: "daily", dowSet: true, wantErr: true},
+		{scheduleType: "daily_alt_even", domSet: true, wantErr: true},
+		{scheduleType: "weekly", dowSet: true, domSet: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := validateBackupSchedule(tt.scheduleType, tt.dowSet, tt.domSet)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateBackupSchedule(%q, %t, %t) = %v, want error %t",
+				tt.scheduleType, tt.dowSet, tt.domSet, err, tt.wantErr)
+		}
+	}
+}
+
+func testAccCheckVultrInstanceBackupScheduleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vultr_instance_backup_schedule" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+		backup, resp, err := client.Instance.GetBackupSchedule(context.Background(), rs.Primary.ID)
+		if err != nil {
+			if isNotFound(resp, err) || strings.Contains(err.Error(), "Server is pending destruction") {
+				return nil
+			}
+			return fmt.Errorf("error getting backup schedule: %s", err)
+		}
+
+		if backupStatus(backup.Enabled) == "enabled" {
+			return fmt.Errorf("backups of instance %s are still enabled", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccVultrInstanceBackupScheduleBase(name, day, scheduleType string) string {
+	return fmt.Sprintf(`
+		resource "vultr_instance" "test" {
+			plan = "vc2-1c-1gb"
+			region = "ewr"
+			os_id = 1743
+			label = "%s"
+			backups = "enabled"
+
+			lifecycle {
+				ignore_changes = [backups_schedule]
+			}
+		}
+
+		resource "vultr_instance_backup_schedule" "test" {
+			instance_id = vultr_instance.test.id
+			type = "%s"
+			hour = 3
+			%s
+		}`, name, scheduleType, day)
+}
@@ -2,8 +2,9 @@ package vultr
 
 import (
 	"context"
-	"log"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vultr/govultr/v3"
@@ -52,7 +53,7 @@ func resourceVultrInstanceIPV4Create(ctx context.Context, d *schema.ResourceData
 
 	instanceID := d.Get("instance_id").(string)
 
-	log.Printf("[INFO] Creating IPv4")
+	tflog.Info(ctx, "Creating IPv4")
 
 	ip, _, err := client.Instance.CreateIPv4(ctx, instanceID, govultr.BoolToBoolPtr(d.Get("reboot").(bool)))
 	if err != nil {
@@ -72,32 +73,24 @@ func resourceVultrInstanceIPV4Read(ctx context.Context, d *schema.ResourceData,
 
 	instanceID := d.Get("instance_id").(string)
 
-	var ipv4 *govultr.IPv4
-	options := &govultr.ListOptions{}
-
-	for {
-		ips, meta, _, err := client.Instance.ListIPv4(ctx, instanceID, options)
-		if err != nil {
-			return diag.Errorf("error getting IPv4s: %v", err)
-		}
-
-		for i := range ips {
-			if ips[i].IP == d.Id() {
-				ipv4 = &ips[i]
-				break
-			}
+	ips, err := listInstanceIPv4s(ctx, client, instanceID)
+	if err != nil {
+		if removeIfGone(ctx, d, nil, err) {
+			return nil
 		}
+		return apiErrorf(nil, err, "error getting IPv4s: %v", err)
+	}
 
-		if meta.Links.Next == "" {
+	var ipv4 *govultr.IPv4
+	for i := range ips {
+		if ips[i].IP == d.Id() {
+			ipv4 = &ips[i]
 			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
 		}
 	}
 
 	if ipv4 == nil {
-		log.Printf("[WARN] Removing IPv4 (%s) because it is gone", d.Id())
+		tflog.Warn(ctx, fmt.Sprintf("Removing IPv4 (%s) because it is gone", d.Id()))
 		d.SetId("")
 		return nil
 	}
@@ -123,9 +116,9 @@ func resourceVultrInstanceIPV4Delete(ctx context.Context, d *schema.ResourceData
 
 	instanceID := d.Get("instance_id").(string)
 
-	log.Printf("[INFO] Deleting IPv4: %s", d.Id())
-	if err := client.Instance.DeleteIPv4(ctx, instanceID, d.Id()); err != nil {
-		return diag.Errorf("error Deleting IPv4 (%s): %v", d.Id(), err)
+	tflog.Info(ctx, fmt.Sprintf("Deleting IPv4: %s", d.Id()))
+	if err := client.Instance.DeleteIPv4(ctx, instanceID, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error Deleting IPv4 (%s): %v", d.Id(), err)
 	}
 
 	return nil
@@ -2,24 +2,30 @@ package vultr
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vultr/govultr/v3"
+	"github.com/vultr/terraform-provider-vultr/vultr/internal/fakevultr"
 )
 
 func TestAccVultrInstanceBasic(t *testing.T) {
 	t.Parallel()
-	rName := acctest.RandomWithPrefix("tf-vps-rs")
+	c := newTestAccCassette(t)
+	rName := c.RandomWithPrefix("tf-vps-rs")
 
 	name := "vultr_instance.test"
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		CheckDestroy:      testAccCheckVultrInstanceDestroy,
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:          c.PreCheck,
+		CheckDestroy:      testAccCheckVultrInstanceDestroyWith(c.Provider()),
+		ProviderFactories: c.ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccVultrInstanceBase(rName),
@@ -245,24 +251,343 @@ func TestAccVultrInstanceUpdateTags(t *testing.T) {
 	})
 }
 
-func testAccCheckVultrInstanceDestroy(s *terraform.State) error {
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "vultr_instance" {
-			continue
+func TestInstancePowerState(t *testing.T) {
+	p, client := testFakeProvider(t)
+	ty := p.ResourcesMap["vultr_instance"].CoreConfigSchema().ImpliedType()
+
+	config := map[string]cty.Value{
+		"region":      cty.StringVal("ewr"),
+		"plan":        cty.StringVal("vc2-1c-1gb"),
+		"os_id":       cty.NumberIntVal(1743),
+		"power_state": cty.StringVal("stopped"),
+	}
+
+	state := testApplyResource(t, p, "vultr_instance", cty.NullVal(ty), config)
+	id := state.GetAttr("id").AsString()
+
+	assertPowerStatus := func(want string) {
+		t.Helper()
+
+		instance, _, err := client.Instance.Get(context.Background(), id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if instance.PowerStatus != want {
+			t.Errorf("expected the instance to be %s, got %s", want, instance.PowerStatus)
+		}
+		if got := state.GetAttr("power_state").AsString(); got != want {
+			t.Errorf("expected power_state to be %s, got %s", want, got)
+		}
+	}
+	assertPowerStatus("stopped")
+
+	// A plan change leaves a stopped instance stopped
+	config["plan"] = cty.StringVal("vc2-2c-4gb")
+	state = testApplyResource(t, p, "vultr_instance", state, config)
+	assertPowerStatus("stopped")
+
+	config["power_state"] = cty.StringVal("running")
+	state = testApplyResource(t, p, "vultr_instance", state, config)
+	assertPowerStatus("running")
+
+	// Once power_state is removed the instance is left as it is
+	if err := client.Instance.Halt(context.Background(), id); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	delete(config, "power_state")
+	config["label"] = cty.StringVal("unmanaged-power")
+	state = testApplyResource(t, p, "vultr_instance", state, config)
+	assertPowerStatus("stopped")
+
+	// Even then, a plan change that boots the instance halts it again
+	config["plan"] = cty.StringVal("vc2-4c-8gb")
+	state = testApplyResource(t, p, "vultr_instance", state, config)
+	assertPowerStatus("stopped")
+	if got := state.GetAttr("plan").AsString(); got != "vc2-4c-8gb" {
+		t.Errorf("expected the new plan, got %s", got)
+	}
+}
+
+func TestInstanceReinstallOnChange(t *testing.T) {
+	p, client, api := testFakeProviderAPI(t)
+	ty := p.ResourcesMap["vultr_instance"].CoreConfigSchema().ImpliedType()
+
+	config := map[string]cty.Value{
+		"region":    cty.StringVal("ewr"),
+		"plan":      cty.StringVal("vc2-1c-1gb"),
+		"os_id":     cty.NumberIntVal(1743),
+		"hostname":  cty.StringVal("first"),
+		"user_data": cty.StringVal("#cloud-config\n"),
+		"backups":   cty.StringVal("enabled"),
+		"backups_schedule": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"type": cty.StringVal("weekly"),
+			"hour": cty.NumberIntVal(3),
+			"dow":  cty.NumberIntVal(2),
+			"dom":  cty.NumberIntVal(0),
+		})}),
+	}
+
+	state := testApplyResource(t, p, "vultr_instance", cty.NullVal(ty), config)
+	id := state.GetAttr("id").AsString()
+
+	// Without reinstall_on_change the instance is replaced
+	config["hostname"] = cty.StringVal("second")
+	config["user_data"] = cty.StringVal("#cloud-config\npackages: [nginx]\n")
+	config["os_id"] = cty.NumberIntVal(2284)
+	plan := testPlanResource(t, p, "vultr_instance", state, config)
+	if len(plan.RequiresReplace) == 0 {
+		t.Fatal("expected the hostname, user data and OS to replace the instance")
+	}
+
+	// A hostname and an OS can't be applied by one rebuild
+	config["reinstall_on_change"] = cty.True
+	plan = testPlanResourceChange(t, p, "vultr_instance", state, config)
+	if len(plan.Diagnostics) == 0 {
+		t.Fatal("expected an error for changing the hostname and OS together")
+	}
+
+	assertRebuilt := func(want int) {
+		t.Helper()
+
+		if state.GetAttr("id").AsString() != id {
+			t.Fatal("expected the instance to be reinstalled in place")
+		}
+		if got := api.Rebuilds(id); got != want {
+			t.Errorf("expected the instance to be rebuilt %d times, got %d", want, got)
 		}
 
-		client := testAccProvider.Meta().(*Client).govultrClient()
-		_, _, err := client.Instance.Get(context.Background(), rs.Primary.ID)
+		backup, _, err := client.Instance.GetBackupSchedule(context.Background(), id)
 		if err != nil {
-			if strings.Contains(err.Error(), "Server is pending destruction") {
-				return nil
-			}
-			return fmt.Errorf("error getting instance: %s", err)
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if backup.Type != "weekly" || backup.Dow != 2 || backup.Hour != 3 {
+			t.Errorf("expected the backup schedule to be applied again, got %+v", backup)
+		}
+	}
+
+	// The OS change installs the new user data, without another reinstall
+	config["hostname"] = cty.StringVal("first")
+	plan = testPlanResource(t, p, "vultr_instance", state, config)
+	if len(plan.RequiresReplace) != 0 {
+		t.Fatalf("expected the instance to be reinstalled in place, got %v", plan.RequiresReplace)
+	}
+
+	state = testApplyResource(t, p, "vultr_instance", state, config)
+	assertRebuilt(1)
+
+	userData, _, err := client.Instance.GetUserData(context.Background(), id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := base64.StdEncoding.EncodeToString([]byte("#cloud-config\npackages: [nginx]\n")); userData.Data != want {
+		t.Errorf("expected the new user data, got %q", userData.Data)
+	}
+
+	config["hostname"] = cty.StringVal("second")
+	state = testApplyResource(t, p, "vultr_instance", state, config)
+	assertRebuilt(2)
+
+	instance, _, err := client.Instance.Get(context.Background(), id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if instance.Hostname != "second" || instance.OsID != 2284 {
+		t.Errorf("expected the new hostname and OS, got %s and %d", instance.Hostname, instance.OsID)
+	}
+}
+
+func TestInstanceReinstallWaitsForRebuild(t *testing.T) {
+	p, client, api := testFakeProviderAPI(t, fakevultr.WithRebuildLag(3))
+	ty := p.ResourcesMap["vultr_instance"].CoreConfigSchema().ImpliedType()
+
+	config := map[string]cty.Value{
+		"region":              cty.StringVal("ewr"),
+		"plan":                cty.StringVal("vc2-1c-1gb"),
+		"os_id":               cty.NumberIntVal(1743),
+		"user_data":           cty.StringVal("#cloud-config\n"),
+		"backups":             cty.StringVal("enabled"),
+		"reinstall_on_change": cty.True,
+	}
+
+	state := testApplyResource(t, p, "vultr_instance", cty.NullVal(ty), config)
+	id := state.GetAttr("id").AsString()
+
+	// A schedule from outside the resource survives the reinstall
+	if _, err := client.Instance.SetBackupSchedule(context.Background(), id, &govultr.BackupScheduleReq{
+		Type: "daily",
+		Hour: govultr.IntToIntPtr(5),
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config["user_data"] = cty.StringVal("#cloud-config\npackages: [nginx]\n")
+	state = testApplyResource(t, p, "vultr_instance", state, config)
+
+	if got := api.Rebuilds(id); got != 1 {
+		t.Errorf("expected the instance to be rebuilt once, got %d", got)
+	}
+	status, serverStatus := state.GetAttr("status").AsString(), state.GetAttr("server_status").AsString()
+	if status != "active" || serverStatus != "ok" {
+		t.Errorf("expected the wait to outlast the rebuild, got %s and %s", status, serverStatus)
+	}
+
+	backup, _, err := client.Instance.GetBackupSchedule(context.Background(), id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if backup.Type != "daily" || backup.Hour != 5 {
+		t.Errorf("expected the backup schedule to be applied again, got %+v", backup)
+	}
+}
+
+func TestInstanceRestore(t *testing.T) {
+	p, client, api := testFakeProviderAPI(t)
+	ty := p.ResourcesMap["vultr_instance"].CoreConfigSchema().ImpliedType()
+
+	restore := func(backupID, snapshotID string) cty.Value {
+		backup, snapshot := cty.NullVal(cty.String), cty.NullVal(cty.String)
+		if backupID != "" {
+			backup = cty.StringVal(backupID)
+		}
+		if snapshotID != "" {
+			snapshot = cty.StringVal(snapshotID)
+		}
+		return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"backup_id":   backup,
+			"snapshot_id": snapshot,
+		})})
+	}
+
+	config := map[string]cty.Value{
+		"region":  cty.StringVal("ewr"),
+		"plan":    cty.StringVal("vc2-1c-1gb"),
+		"os_id":   cty.NumberIntVal(1743),
+		"backups": cty.StringVal("enabled"),
+		"backups_schedule": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"type": cty.StringVal("daily"),
+			"hour": cty.NumberIntVal(4),
+			"dow":  cty.NumberIntVal(0),
+			"dom":  cty.NumberIntVal(0),
+		})}),
+		"restore": restore("backup-0", ""),
+	}
+
+	state := testApplyResource(t, p, "vultr_instance", cty.NullVal(ty), config)
+	id := state.GetAttr("id").AsString()
+
+	rebuilds := 0
+	assertRestored := func(want string) {
+		t.Helper()
+
+		rebuilds++
+		if got := api.Rebuilds(id); got != rebuilds {
+			t.Errorf("expected the instance to be rebuilt %d times, got %d", rebuilds, got)
+		}
+
+		if got := state.GetAttr("last_restored_source").AsString(); got != want {
+			t.Errorf("expected last_restored_source to be %q, got %q", want, got)
+		}
+
+		instance, _, err := client.Instance.Get(context.Background(), id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if instance.Status != "active" || instance.ServerStatus != "ok" {
+			t.Errorf("expected the instance to have booted, got %s and %s", instance.Status, instance.ServerStatus)
+		}
+
+		backup, _, err := client.Instance.GetBackupSchedule(context.Background(), id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if backup.Type != "daily" || backup.Hour != 4 {
+			t.Errorf("expected the backup schedule to be applied again, got %+v", backup)
 		}
+	}
+	assertRestored("backup_id:backup-0")
+
+	config["restore"] = restore("backup-1", "")
+	state = testApplyResource(t, p, "vultr_instance", state, config)
+	assertRestored("backup_id:backup-1")
+
+	config["restore"] = restore("", "snapshot-1")
+	state = testApplyResource(t, p, "vultr_instance", state, config)
+	assertRestored("snapshot_id:snapshot-1")
+
+	config["restore_trigger"] = cty.StringVal("2026-10-17")
+	state = testApplyResource(t, p, "vultr_instance", state, config)
+	assertRestored("snapshot_id:snapshot-1")
+
+	// Invalid backup arguments fail the update before the instance is restored
+	config["backups"] = cty.StringVal("disabled")
+	config["restore_trigger"] = cty.StringVal("2026-10-18")
+	if apply := testApplyResourceChange(t, p, "vultr_instance", state, config); len(apply.Diagnostics) == 0 {
+		t.Fatal("expected an error for disabling backups with a backups_schedule")
+	}
+	if got := api.Rebuilds(id); got != rebuilds {
+		t.Errorf("expected the instance not to be restored, got %d rebuilds", got)
+	}
+}
+
+func TestInstanceRestoreWaitsForRebuild(t *testing.T) {
+	p, _, api := testFakeProviderAPI(t, fakevultr.WithRebuildLag(3))
+	ty := p.ResourcesMap["vultr_instance"].CoreConfigSchema().ImpliedType()
+
+	config := map[string]cty.Value{
+		"region": cty.StringVal("ewr"),
+		"plan":   cty.StringVal("vc2-1c-1gb"),
+		"os_id":  cty.NumberIntVal(1743),
+		"restore": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"backup_id":   cty.StringVal("backup-0"),
+			"snapshot_id": cty.NullVal(cty.String),
+		})}),
+	}
 
-		return fmt.Errorf("instance %s still exists", rs.Primary.ID)
+	// The restore only shows up in the server status after a few reads,
+	// while the status stays active throughout
+	assertBooted := func(state cty.Value, rebuilds int) {
+		t.Helper()
+
+		if got := api.Rebuilds(state.GetAttr("id").AsString()); got != rebuilds {
+			t.Errorf("expected the instance to be rebuilt %d times, got %d", rebuilds, got)
+		}
+		if got := state.GetAttr("server_status").AsString(); got != "ok" {
+			t.Errorf("expected the wait to outlast the restore, got server_status %s", got)
+		}
+	}
+
+	state := testApplyResource(t, p, "vultr_instance", cty.NullVal(ty), config)
+	assertBooted(state, 1)
+
+	config["restore_trigger"] = cty.StringVal("2026-10-17")
+	state = testApplyResource(t, p, "vultr_instance", state, config)
+	assertBooted(state, 2)
+}
+
+func testAccCheckVultrInstanceDestroy(s *terraform.State) error {
+	return testAccCheckVultrInstanceDestroyWith(testAccProvider)(s)
+}
+
+func testAccCheckVultrInstanceDestroyWith(p *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "vultr_instance" {
+				continue
+			}
+
+			client := p.Meta().(*Client).govultrClient()
+			_, _, err := client.Instance.Get(context.Background(), rs.Primary.ID)
+			if err != nil {
+				if strings.Contains(err.Error(), "Server is pending destruction") {
+					return nil
+				}
+				return fmt.Errorf("error getting instance: %s", err)
+			}
+
+			return fmt.Errorf("instance %s still exists", rs.Primary.ID)
+		}
+		return nil
 	}
-	return nil
 }
 
 func testAccVultrInstanceBase(name string) string {
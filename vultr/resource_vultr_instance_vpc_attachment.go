@@ -0,0 +1,244 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+func resourceVultrInstanceVPCAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrInstanceVPCAttachmentCreate,
+		ReadContext:   resourceVultrInstanceVPCAttachmentRead,
+		DeleteContext: resourceVultrInstanceVPCAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVultrInstanceVPCAttachmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"vpc_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"vpc_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntInSlice([]int{1, 2}),
+				Description:  "Whether vpc_id is a VPC (1) or a VPC 2.0 (2) network.",
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The IP address of the instance in the network. It can only be set for VPC 2.0 networks.",
+			},
+			"mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVultrInstanceVPCAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	instanceID := d.Get("instance_id").(string)
+	vpcID := d.Get("vpc_id").(string)
+	version := d.Get("vpc_version").(int)
+	ip, ipOK := d.GetOk("ip_address")
+
+	if version == 1 && ipOK {
+		return diag.Errorf("ip_address can only be set when attaching a VPC 2.0 network")
+	}
+
+	// An attachment that already exists is managed somewhere else, most likely
+	// by the vpc_ids or vpc2_ids of the instance, which would detach it again
+	_, found, err := getInstanceVPCAttachment(ctx, client, instanceID, vpcID, version)
+	if err != nil {
+		return apiErrorf(nil, err, "error getting VPCs of instance %s : %v", instanceID, err)
+	}
+	if found {
+		return diag.Errorf("VPC %s is already attached to instance %s. Remove it from the vpc_ids or vpc2_ids of "+
+			"the instance, or import the attachment, so that only one resource manages it", vpcID, instanceID)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Attaching VPC %s to instance %s", vpcID, instanceID))
+
+	if version == 2 {
+		req := &govultr.AttachVPC2Req{VPCID: vpcID}
+		if ipOK {
+			req.IPAddress = govultr.StringToStringPtr(ip.(string))
+		}
+		err = client.Instance.AttachVPC2(ctx, instanceID, req)
+	} else {
+		err = client.Instance.AttachVPC(ctx, instanceID, vpcID)
+	}
+	if err != nil {
+		return diag.Errorf("error attaching VPC %s to instance %s : %v", vpcID, instanceID, err)
+	}
+
+	d.SetId(instanceVPCAttachmentID(instanceID, vpcID))
+
+	wait := &stateWait[*govultr.VPC2Info]{
+		Pending: []string{"detached"},
+		Target:  []string{"attached"},
+		Refresh: func() (*govultr.VPC2Info, string, error) {
+			info, found, err := getInstanceVPCAttachment(ctx, client, instanceID, vpcID, version)
+			if err != nil || !found {
+				return nil, "detached", err
+			}
+			return info, "attached", nil
+		},
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+	if _, err := wait.wait(ctx); err != nil {
+		return diag.Errorf("error while waiting for VPC %s to be attached to instance %s : %v", vpcID, instanceID, err)
+	}
+
+	return resourceVultrInstanceVPCAttachmentRead(ctx, d, meta)
+}
+
+func resourceVultrInstanceVPCAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	instanceID := d.Get("instance_id").(string)
+	vpcID := d.Get("vpc_id").(string)
+
+	info, found, err := getInstanceVPCAttachment(ctx, client, instanceID, vpcID, d.Get("vpc_version").(int))
+	if err != nil {
+		if removeIfGone(ctx, d, nil, err) {
+			return nil
+		}
+		return apiErrorf(nil, err, "error getting VPCs of instance %s : %v", instanceID, err)
+	}
+
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("Removing VPC attachment (%s) from state because it is gone", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("ip_address", info.IPAddress); err != nil {
+		return diag.Errorf("unable to set resource instance_vpc_attachment `ip_address` read value: %v", err)
+	}
+	if err := d.Set("mac_address", info.MacAddress); err != nil {
+		return diag.Errorf("unable to set resource instance_vpc_attachment `mac_address` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrInstanceVPCAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	instanceID := d.Get("instance_id").(string)
+	vpcID := d.Get("vpc_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Detaching VPC %s from instance %s", vpcID, instanceID))
+
+	var err error
+	if d.Get("vpc_version").(int) == 2 {
+		err = client.Instance.DetachVPC2(ctx, instanceID, vpcID)
+	} else {
+		err = client.Instance.DetachVPC(ctx, instanceID, vpcID)
+	}
+	if err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error detaching VPC %s from instance %s : %v", vpcID, instanceID, err)
+	}
+
+	return nil
+}
+
+func resourceVultrInstanceVPCAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	parts, err := ParseImportID("vultr_instance_vpc_attachment", d.Id())
+	if err != nil {
+		return nil, err
+	}
+	instanceID, vpcID := parts["instance_id"], parts["vpc_id"]
+
+	// The ID doesn't say which kind of network it is, so both are looked up
+	for _, version := range []int{2, 1} {
+		_, found, err := getInstanceVPCAttachment(ctx, client, instanceID, vpcID, version)
+		if err != nil {
+			return nil, fmt.Errorf("error getting VPCs of instance %s : %v", instanceID, err)
+		}
+		if !found {
+			continue
+		}
+
+		d.SetId(instanceVPCAttachmentID(instanceID, vpcID))
+		if err := d.Set("instance_id", instanceID); err != nil {
+			return nil, fmt.Errorf("unable to set resource instance_vpc_attachment `instance_id` import value: %v", err)
+		}
+		if err := d.Set("vpc_id", vpcID); err != nil {
+			return nil, fmt.Errorf("unable to set resource instance_vpc_attachment `vpc_id` import value: %v", err)
+		}
+		if err := d.Set("vpc_version", version); err != nil {
+			return nil, fmt.Errorf("unable to set resource instance_vpc_attachment `vpc_version` import value: %v", err)
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+
+	return nil, fmt.Errorf("VPC %s is not attached to instance %s", vpcID, instanceID)
+}
+
+func instanceVPCAttachmentID(instanceID, vpcID string) string {
+	return instanceID + "," + vpcID
+}
+
+// getInstanceVPCAttachment looks a network up among those attached to an
+// instance. VPC networks are returned in a VPC2Info, which has the same
+// fields as a VPCInfo.
+func getInstanceVPCAttachment(ctx context.Context, client *govultr.Client, instanceID, vpcID string, version int) (*govultr.VPC2Info, bool, error) { //nolint:lll
+	var attached []govultr.VPC2Info
+
+	if version == 2 {
+		vpcs, err := listAll(ctx, nil, func(v govultr.VPC2Info) string { return v.ID },
+			func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.VPC2Info, *govultr.Meta, error) {
+				vpcs, meta, _, err := client.Instance.ListVPC2Info(ctx, instanceID, opts)
+				return vpcs, meta, err
+			})
+		if err != nil {
+			return nil, false, err
+		}
+		attached = vpcs
+	} else {
+		vpcs, err := listAll(ctx, nil, func(v govultr.VPCInfo) string { return v.ID },
+			func(ctx context.Context, opts *govultr.ListOptions) ([]govultr.VPCInfo, *govultr.Meta, error) {
+				vpcs, meta, _, err := client.Instance.ListVPCInfo(ctx, instanceID, opts)
+				return vpcs, meta, err
+			})
+		if err != nil {
+			return nil, false, err
+		}
+		for _, v := range vpcs {
+			attached = append(attached, govultr.VPC2Info{ID: v.ID, MacAddress: v.MacAddress, IPAddress: v.IPAddress})
+		}
+	}
+
+	i := slices.IndexFunc(attached, func(v govultr.VPC2Info) bool { return v.ID == vpcID })
+	if i == -1 {
+		return nil, false, nil
+	}
+	return &attached[i], true, nil
+}
@@ -0,0 +1,191 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vultr/govultr/v3"
+)
+
+func TestAccVultrInstanceVPCAttachment(t *testing.T) {
+	t.Parallel()
+	rName := acctest.RandomWithPrefix("tf-vps-vpc-att")
+
+	name := "vultr_instance_vpc_attachment.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrInstanceVPCAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrInstanceVPCAttachmentBase(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "instance_id", "vultr_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(name, "vpc_id", "vultr_vpc2.test", "id"),
+					resource.TestCheckResourceAttr(name, "vpc_version", "2"),
+					resource.TestCheckResourceAttrSet(name, "mac_address"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Test importing with a malformed ID provides expected error.
+			{
+				ResourceName:  name,
+				ImportState:   true,
+				ImportStateId: "malformed",
+				ExpectError:   regexp.MustCompile("invalid import format"),
+			},
+		},
+	})
+}
+
+func TestInstanceVPCAttachment(t *testing.T) {
+	p, client := testFakeProvider(t)
+	ctx := context.Background()
+	r := p.ResourcesMap["vultr_instance_vpc_attachment"]
+
+	instance, _, err := client.Instance.Create(ctx, &govultr.InstanceCreateReq{
+		Region: "ewr", Plan: "vc2-1c-1gb", OsID: 1743,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	vpc2, _, err := client.VPC2.Create(ctx, &govultr.VPC2Req{Region: "ewr", Description: "attachment"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config := map[string]cty.Value{
+		"instance_id": cty.StringVal(instance.ID),
+		"vpc_id":      cty.StringVal(vpc2.ID),
+		"vpc_version": cty.NumberIntVal(2),
+	}
+	prior := cty.NullVal(r.CoreConfigSchema().ImpliedType())
+	state := testApplyResource(t, p, "vultr_instance_vpc_attachment", prior, config)
+
+	if got, want := state.GetAttr("id").AsString(), instance.ID+","+vpc2.ID; got != want {
+		t.Errorf("expected ID %q, got %q", want, got)
+	}
+	if state.GetAttr("mac_address").AsString() == "" {
+		t.Error("expected the MAC address of the attachment")
+	}
+
+	vpc2s, err := getVPC2s(ctx, client, instance.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(vpc2s) != 1 || vpc2s[0] != vpc2.ID {
+		t.Fatalf("expected the VPC 2.0 network to be attached, got %v", vpc2s)
+	}
+
+	raw := map[string]interface{}{"instance_id": instance.ID, "vpc_id": vpc2.ID, "vpc_version": 2}
+
+	// A second resource for the same attachment is reported as a conflict
+	diags := r.CreateContext(ctx, schema.TestResourceDataRaw(t, r.Schema, raw), p.Meta())
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "already attached") {
+		t.Errorf("expected a conflict with the existing attachment, got %v", diags)
+	}
+
+	// Inline VPC sets warn about networks attached outside of them
+	instanceResource := p.ResourcesMap["vultr_instance"]
+	d := schema.TestResourceDataRaw(t, instanceResource.Schema, map[string]interface{}{
+		"region": "ewr", "plan": "vc2-1c-1gb", "vpc2_ids": []interface{}{"other-vpc2"},
+	})
+	d.SetId(instance.ID)
+	diags = instanceResource.ReadContext(ctx, d, p.Meta())
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, vpc2.ID) {
+		t.Errorf("expected a warning about the attachment, got %v", diags)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	d.SetId(instance.ID + "," + vpc2.ID)
+	imported, err := r.Importer.StateContext(ctx, d, p.Meta())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := imported[0].Get("vpc_version").(int); got != 2 {
+		t.Errorf("expected the import to find a VPC 2.0 network, got version %d", got)
+	}
+
+	for id, want := range map[string]string{
+		instance.ID:                   "invalid import format",
+		instance.ID + ",":             "invalid import format",
+		instance.ID + ",missing-vpc2": "is not attached",
+	} {
+		d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+		d.SetId(id)
+		if _, err := r.Importer.StateContext(ctx, d, p.Meta()); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected importing %q to fail with %q, got %v", id, want, err)
+		}
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId(state.GetAttr("id").AsString())
+	if diags := r.DeleteContext(ctx, d, p.Meta()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	vpc2s, err = getVPC2s(ctx, client, instance.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(vpc2s) != 0 {
+		t.Errorf("expected the VPC 2.0 network to be detached, got %v", vpc2s)
+	}
+}
+
+func testAccCheckVultrInstanceVPCAttachmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vultr_instance_vpc_attachment" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+		instanceID, vpcID := rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["vpc_id"]
+		_, found, err := getInstanceVPCAttachment(context.Background(), client, instanceID, vpcID, 2)
+		if err != nil {
+			if isNotFound(nil, err) || strings.Contains(err.Error(), "Server is pending destruction") {
+				return nil
+			}
+			return fmt.Errorf("error getting VPCs of instance: %s", err)
+		}
+
+		if found {
+			return fmt.Errorf("VPC %s is still attached to instance %s", vpcID, instanceID)
+		}
+	}
+	return nil
+}
+
+func testAccVultrInstanceVPCAttachmentBase(name string) string {
+	return fmt.Sprintf(`
+		resource "vultr_vpc2" "test" {
+			region      = "ewr"
+			description = "%[1]s"
+		}
+
+		resource "vultr_instance" "test" {
+			plan = "vc2-1c-1gb"
+			region = "ewr"
+			os_id = 1743
+			label = "%[1]s"
+		}
+
+		resource "vultr_instance_vpc_attachment" "test" {
+			instance_id = vultr_instance.test.id
+			vpc_id = vultr_vpc2.test.id
+			vpc_version = 2
+		}`, name)
+}
@@ -4,15 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net"
 	"strings"
 	"time"
 
 	"github.com/vultr/govultr/v3"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -55,13 +54,17 @@ func resourceVultrIsoPrivate() *schema.Resource {
 				Computed: true,
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
 func resourceVultrIsoCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Creating new ISO")
+	tflog.Info(ctx, "Creating new ISO")
 
 	isoReq := &govultr.ISOReq{URL: d.Get("url").(string)}
 	iso, _, err := client.ISO.Create(ctx, isoReq)
@@ -71,7 +74,7 @@ func resourceVultrIsoCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(iso.ID)
 
-	_, err = waitForIsoAvailable(ctx, d, "complete", []string{"pending"}, "status", meta)
+	_, err = waitForIsoAvailable(ctx, d, "complete", []string{"pending"}, "status", d.Timeout(schema.TimeoutCreate), meta)
 	if err != nil {
 		return diag.Errorf(
 			"error while waiting for ISO %s detach to be completed: %s", d.Id(), err)
@@ -83,14 +86,12 @@ func resourceVultrIsoCreate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceVultrIsoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	iso, _, err := client.ISO.Get(ctx, d.Id())
+	iso, resp, err := client.ISO.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains("Invalid iso", err.Error()) {
-			log.Printf("[WARN] Removing ISO (%s) because it is gone", d.Id())
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("Error getting ISO %s : %v", d.Id(), err)
+		return apiErrorf(resp, err, "Error getting ISO %s : %v", d.Id(), err)
 	}
 
 	if err := d.Set("date_created", iso.DateCreated); err != nil {
@@ -118,14 +119,11 @@ func resourceVultrIsoRead(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceVultrIsoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting iso : %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting iso : %s", d.Id()))
 
-	if err := client.ISO.Delete(ctx, d.Id()); err != nil {
+	if err := client.ISO.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
 		// decode the error
-		var attachedErr struct {
-			Error  string `json:"error"`
-			Status int    `json:"status"`
-		}
+		var attachedErr apiErrorBody
 
 		if unmarshalError := json.Unmarshal([]byte(err.Error()), &attachedErr); unmarshalError != nil {
 			return diag.Errorf(
@@ -150,41 +148,31 @@ func resourceVultrIsoDelete(ctx context.Context, d *schema.ResourceData, meta in
 			return diag.Errorf("error deleting ISO %s : failed to parse IP to which ISO is attached: %s", d.Id(), ip)
 		}
 
-		// default is 100 instances
-		var options govultr.ListOptions
-		for {
-			instances, responseMeta, _, err := client.Instance.List(ctx, &options)
-			if err != nil {
-				return diag.Errorf("error deleting ISO %s : failed to list instances for detaching ISO: %v", d.Id(), err)
-			}
+		instances, err := listInstances(ctx, client, nil)
+		if err != nil {
+			return diag.Errorf("error deleting ISO %s : failed to list instances for detaching ISO: %v", d.Id(), err)
+		}
 
-			// check for the instance with this IP, return on failure or discovery
-			for _, instance := range instances {
-				if instance.MainIP == ip {
-					if _, err := client.Instance.DetachISO(ctx, instance.ID); err != nil {
-						return diag.Errorf("error deleting ISO %s : failed to detach from instances %s : %v", d.Id(), instance.ID, err)
-					}
-					_, err := waitForIsoDetached(ctx, instance.ID, "ready", []string{"isomounted"}, meta)
-					if err != nil {
-						return diag.Errorf(
-							"error deleting ISO %s: failed to wait for ISO to detach from instance %s: %s",
-							d.Id(),
-							instance.ID,
-							err,
-						)
-					}
-					if err = client.ISO.Delete(ctx, d.Id()); err != nil {
-						return diag.Errorf("error deleting ISO %s: failed to delete ISO: %s", d.Id(), err)
-					}
-					return nil
+		// check for the instance with this IP, return on failure or discovery
+		for _, instance := range instances {
+			if instance.MainIP == ip {
+				if _, err := client.Instance.DetachISO(ctx, instance.ID); err != nil {
+					return diag.Errorf("error deleting ISO %s : failed to detach from instances %s : %v", d.Id(), instance.ID, err)
 				}
+				_, err := waitForIsoDetached(ctx, instance.ID, "ready", []string{"isomounted"}, d.Timeout(schema.TimeoutDelete), meta)
+				if err != nil {
+					return diag.Errorf(
+						"error deleting ISO %s: failed to wait for ISO to detach from instance %s: %s",
+						d.Id(),
+						instance.ID,
+						err,
+					)
+				}
+				if err = client.ISO.Delete(ctx, d.Id()); err != nil {
+					return diag.Errorf("error deleting ISO %s: failed to delete ISO: %s", d.Id(), err)
+				}
+				return nil
 			}
-
-			// no more instances to check
-			if responseMeta.Links.Next == "" {
-				break
-			}
-			options.Cursor = responseMeta.Links.Next
 		}
 		return diag.Errorf("failed to identify instance associated with IP %s for deleting ISO %s", ip, d.Id())
 	}
@@ -192,67 +180,60 @@ func resourceVultrIsoDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
-func waitForIsoAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for ISO (%s) to have %s of %s",
-		d.Id(), attribute, target)
+func waitForIsoAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (*govultr.ISO, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for ISO (%s) to have %s of %s",
+		d.Id(), attribute, target))
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    pending,
-		Target:     []string{target},
-		Refresh:    newIsoStateRefresh(ctx, d, meta),
-		Timeout:    60 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-
-		NotFoundChecks: 60,
+	wait := &stateWait[*govultr.ISO]{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: newIsoStateRefresh(ctx, d, meta),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
 func newIsoStateRefresh(ctx context.Context,
-	d *schema.ResourceData, meta interface{}) retry.StateRefreshFunc {
+	d *schema.ResourceData, meta interface{}) func() (*govultr.ISO, string, error) {
 	client := meta.(*Client).govultrClient()
 
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Creating Private ISO")
+	return func() (*govultr.ISO, string, error) {
+		tflog.Info(ctx, "Creating Private ISO")
 		iso, _, err := client.ISO.Get(ctx, d.Id())
 		if err != nil {
 			return nil, "", fmt.Errorf("error retrieving ISO %s : %s", d.Id(), err)
 		}
 
-		log.Printf("[INFO] The ISO Status is %s", iso.Status)
-		return &iso, iso.Status, nil
+		tflog.Info(ctx, fmt.Sprintf("The ISO Status is %s", iso.Status))
+		return iso, iso.Status, nil
 	}
 }
 
-func waitForIsoDetached(ctx context.Context, instanceID string, target string, pending []string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for ISO to detach from %s",
-		instanceID)
-
-	stateConf := &retry.StateChangeConf{
-		Pending:        pending,
-		Target:         []string{target},
-		Refresh:        isoDetachStateRefresh(ctx, instanceID, meta),
-		Timeout:        60 * time.Minute,
-		Delay:          10 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 60,
+func waitForIsoDetached(ctx context.Context, instanceID string, target string, pending []string, timeout time.Duration, meta interface{}) (*govultr.Iso, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for ISO to detach from %s",
+		instanceID))
+
+	wait := &stateWait[*govultr.Iso]{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: isoDetachStateRefresh(ctx, instanceID, meta),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
-func isoDetachStateRefresh(ctx context.Context, instanceID string, meta interface{}) retry.StateRefreshFunc {
+func isoDetachStateRefresh(ctx context.Context, instanceID string, meta interface{}) func() (*govultr.Iso, string, error) {
 	client := meta.(*Client).govultrClient()
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Detaching ISO")
+	return func() (*govultr.Iso, string, error) {
+		tflog.Info(ctx, "Detaching ISO")
 		iso, _, err := client.Instance.ISOStatus(ctx, instanceID)
 		if err != nil {
 			return nil, "", fmt.Errorf("error getting ISO status for instance %s : %s", instanceID, err)
 		}
-		return &iso, iso.State, nil
+		return iso, iso.State, nil
 	}
 }
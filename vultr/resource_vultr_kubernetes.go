@@ -3,12 +3,10 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vultr/govultr/v3"
 )
@@ -114,6 +112,9 @@ func resourceVultrKubernetes() *schema.Resource {
 				Sensitive: true,
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
@@ -144,7 +145,7 @@ func resourceVultrKubernetesCreate(ctx context.Context, d *schema.ResourceData,
 	d.SetId(cluster.ID)
 
 	//block until status is ready
-	if _, err = waitForVKEAvailable(ctx, d, "active", []string{"pending"}, "status", meta); err != nil {
+	if _, err = waitForVKEAvailable(ctx, d, "active", []string{"pending"}, "status", d.Timeout(schema.TimeoutCreate), meta); err != nil {
 		return diag.Errorf(
 			"error while waiting for kubernetes cluster %v to be completed: %v", cluster.ID, err)
 	}
@@ -155,17 +156,12 @@ func resourceVultrKubernetesCreate(ctx context.Context, d *schema.ResourceData,
 func resourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	vke, _, err := client.Kubernetes.GetCluster(ctx, d.Id())
+	vke, resp, err := client.Kubernetes.GetCluster(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Unauthorized") {
-			return diag.Errorf("API authorization error: %v", err)
-		}
-		if strings.Contains(err.Error(), "Invalid resource ID") {
-			log.Printf("[WARN] Kubernetes Cluster (%v) not found", d.Id())
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting cluster (%s): %v", d.Id(), err)
+		return apiErrorf(resp, err, "error getting cluster (%s): %v", d.Id(), err)
 	}
 
 	// Look for the node pool with the tag `tf-vke-default`
@@ -318,10 +314,10 @@ func resourceVultrKubernetesUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceVultrKubernetesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Delete VKE : %v", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Delete VKE : %v", d.Id()))
 
-	if err := client.Kubernetes.DeleteCluster(ctx, d.Id()); err != nil {
-		return diag.Errorf("error deleting VKE %v : %v", d.Id(), err)
+	if err := client.Kubernetes.DeleteCluster(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error deleting VKE %v : %v", d.Id(), err)
 	}
 	return nil
 }
@@ -348,28 +344,25 @@ func generateNodePool(pools interface{}) []govultr.NodePoolReq {
 	return npr
 }
 
-func waitForVKEAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for kubernetes cluster (%s) to have %s of %s",
-		d.Id(), attribute, target)
+func waitForVKEAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (*govultr.Cluster, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for kubernetes cluster (%s) to have %s of %s",
+		d.Id(), attribute, target))
 
-	stateConf := &retry.StateChangeConf{
-		Pending:        pending,
-		Target:         []string{target},
-		Refresh:        newVKEStateRefresh(ctx, d, meta, attribute),
-		Timeout:        60 * time.Minute,
-		Delay:          10 * time.Second,
-		MinTimeout:     5 * time.Second,
-		NotFoundChecks: 60,
+	wait := &stateWait[*govultr.Cluster]{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: newVKEStateRefresh(ctx, d, meta, attribute),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
-func newVKEStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) retry.StateRefreshFunc { //nolint:lll
+func newVKEStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) func() (*govultr.Cluster, string, error) { //nolint:lll
 	client := meta.(*Client).govultrClient()
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Creating kubernetes cluster")
+	return func() (*govultr.Cluster, string, error) {
+		tflog.Info(ctx, "Creating kubernetes cluster")
 
 		vke, _, err := client.Kubernetes.GetCluster(ctx, d.Id())
 		if err != nil {
@@ -377,7 +370,7 @@ func newVKEStateRefresh(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 
 		if attr == "status" {
-			log.Printf("[INFO] The kubernetes cluster Status is %v", vke.Status)
+			tflog.Info(ctx, fmt.Sprintf("The kubernetes cluster Status is %v", vke.Status))
 			return vke, vke.Status, nil
 		}
 
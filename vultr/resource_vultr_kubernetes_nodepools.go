@@ -3,12 +3,10 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vultr/govultr/v3"
 )
@@ -21,14 +19,13 @@ func resourceVultrKubernetesNodePools() *schema.Resource {
 		DeleteContext: resourceVultrKubernetesNodePoolsDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-				ids := strings.SplitN(d.Id(), " ", 2)
-				if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
-					err := fmt.Errorf("unexpected format of node pool import IDs (%s): expected 'clusterID nodePoolID'", d.Id())
+				ids, err := ParseImportID("vultr_kubernetes_node_pools", d.Id())
+				if err != nil {
 					return nil, err
 				}
 
-				d.SetId(ids[1])
-				if err := d.Set("cluster_id", ids[0]); err != nil {
+				d.SetId(ids["id"])
+				if err := d.Set("cluster_id", ids["cluster_id"]); err != nil {
 					return nil, fmt.Errorf("unable to set cluster ID for import state function")
 				}
 
@@ -36,6 +33,9 @@ func resourceVultrKubernetesNodePools() *schema.Resource {
 			},
 		},
 		Schema: nodePoolSchema(true),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
@@ -65,7 +65,7 @@ func resourceVultrKubernetesNodePoolsCreate(ctx context.Context, d *schema.Resou
 	}
 
 	//block until status is ready
-	if _, err = waitForNodePoolAvailable(ctx, d, "active", []string{"pending"}, "status", meta); err != nil {
+	if _, err = waitForNodePoolAvailable(ctx, d, "active", []string{"pending"}, "status", d.Timeout(schema.TimeoutCreate), meta); err != nil {
 		return diag.Errorf(
 			"error while waiting for node pool %v to be completed: %v", d.Id(), err)
 	}
@@ -78,17 +78,12 @@ func resourceVultrKubernetesNodePoolsRead(ctx context.Context, d *schema.Resourc
 
 	clusterID := d.Get("cluster_id").(string)
 
-	nodePool, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, d.Id())
+	nodePool, resp, err := client.Kubernetes.GetNodePool(ctx, clusterID, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Unauthorized") {
-			return diag.Errorf("API authorization error: %v", err)
-		}
-		if strings.Contains(err.Error(), "Invalid NodePool ID") {
-			log.Printf("[WARN] Kubernetes NodePool (%v) not found", d.Id())
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting node pool: %v", err)
+		return apiErrorf(resp, err, "error getting node pool: %v", err)
 	}
 
 	if err := d.Set("status", nodePool.Status); err != nil {
@@ -163,35 +158,32 @@ func resourceVultrKubernetesNodePoolsUpdate(ctx context.Context, d *schema.Resou
 func resourceVultrKubernetesNodePoolsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
 	client := meta.(*Client).govultrClient()
 
-	if err := client.Kubernetes.DeleteNodePool(ctx, d.Get("cluster_id").(string), d.Id()); err != nil {
-		return diag.Errorf("error deleting VKE nodepool %v : %v", d.Id(), err)
+	if err := client.Kubernetes.DeleteNodePool(ctx, d.Get("cluster_id").(string), d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error deleting VKE nodepool %v : %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func waitForNodePoolAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for node pool (%s) to have %s of %s",
-		d.Id(), attribute, target)
+func waitForNodePoolAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (*govultr.NodePool, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for node pool (%s) to have %s of %s",
+		d.Id(), attribute, target))
 
-	stateConf := &retry.StateChangeConf{
-		Pending:        pending,
-		Target:         []string{target},
-		Refresh:        newNodePoolStateRefresh(ctx, d, meta, attribute),
-		Timeout:        60 * time.Minute,
-		Delay:          10 * time.Second,
-		MinTimeout:     5 * time.Second,
-		NotFoundChecks: 60,
+	wait := &stateWait[*govultr.NodePool]{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: newNodePoolStateRefresh(ctx, d, meta, attribute),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
-func newNodePoolStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) retry.StateRefreshFunc { //nolint:lll
+func newNodePoolStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) func() (*govultr.NodePool, string, error) { //nolint:lll
 	client := meta.(*Client).govultrClient()
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Creating node pool")
+	return func() (*govultr.NodePool, string, error) {
+		tflog.Info(ctx, "Creating node pool")
 
 		np, _, err := client.Kubernetes.GetNodePool(ctx, d.Get("cluster_id").(string), d.Id())
 		if err != nil {
@@ -199,7 +191,7 @@ func newNodePoolStateRefresh(ctx context.Context, d *schema.ResourceData, meta i
 		}
 
 		if attr == "status" {
-			log.Printf("[INFO] The node pool status is %v", np.Status)
+			tflog.Info(ctx, fmt.Sprintf("The node pool status is %v", np.Status))
 			return np, np.Status, nil
 		}
 
@@ -3,10 +3,10 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -218,6 +218,10 @@ func resourceVultrLoadBalancer() *schema.Resource {
 				Computed: true,
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
@@ -294,13 +298,13 @@ func resourceVultrLoadBalancerCreate(ctx context.Context, d *schema.ResourceData
 	}
 	d.SetId(lb.ID)
 
-	_, err = waitForLBAvailable(ctx, d, "active", []string{"pending", "installing"}, "status", meta)
+	_, err = waitForLBAvailable(ctx, d, "active", []string{"pending", "installing"}, "status", d.Timeout(schema.TimeoutCreate), meta)
 	if err != nil {
 		return diag.Errorf(
 			"error while waiting for load balancer %v to be completed: %v", lb.ID, err)
 	}
 
-	log.Printf("[INFO] load balancer ID: %v", lb.ID)
+	tflog.Info(ctx, fmt.Sprintf("load balancer ID: %v", lb.ID))
 
 	return resourceVultrLoadBalancerRead(ctx, d, meta)
 }
@@ -308,11 +312,12 @@ func resourceVultrLoadBalancerCreate(ctx context.Context, d *schema.ResourceData
 func resourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	lb, _, err := client.LoadBalancer.Get(ctx, d.Id())
+	lb, resp, err := client.LoadBalancer.Get(ctx, d.Id())
 	if err != nil {
-		log.Printf("[WARN] Vultr load balancer (%v) not found", d.Id())
-		d.SetId("")
-		return nil
+		if removeIfGone(ctx, d, resp, err) {
+			return nil
+		}
+		return apiErrorf(resp, err, "error getting load balancer (%s): %v", d.Id(), err)
 	}
 
 	var rulesList []map[string]interface{}
@@ -422,7 +427,7 @@ func resourceVultrLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData
 			ssl := generateSSL(sslData)
 			req.SSL = ssl
 		} else {
-			log.Printf(`[INFO] Removing load balancer SSL certificate (%v)`, d.Id())
+			tflog.Info(ctx, fmt.Sprintf(`Removing load balancer SSL certificate (%v)`, d.Id()))
 			req.SSL = nil
 		}
 	}
@@ -466,7 +471,7 @@ func resourceVultrLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData
 
 	if d.HasChange("attached_instances") {
 		_, newInstances := d.GetChange("attached_instances")
-		log.Println(newInstances)
+		tflog.Info(ctx, fmt.Sprintf("Updating attached instances to %v", newInstances))
 
 		var newIDs []string
 		for _, v := range newInstances.([]interface{}) {
@@ -498,7 +503,7 @@ func resourceVultrLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData
 func resourceVultrLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting load balancer: %v", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting load balancer: %v", d.Id()))
 
 	// items we should detach before we destroy
 	// instances and firewall rules are default "null" if not present in LoadBalancerReq
@@ -515,9 +520,9 @@ func resourceVultrLoadBalancerDelete(ctx context.Context, d *schema.ResourceData
 
 	//It seems the API does not reporting a completely accurate ready/active status.
 	//So we retry the delete until it succeeds.
-	if err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *retry.RetryError {
+	if err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete)-time.Minute, func() *retry.RetryError {
 		err := client.LoadBalancer.Delete(ctx, d.Id())
-		if err != nil {
+		if err != nil && !isNotFound(nil, err) {
 			if strings.Contains(err.Error(), "Load balancer is not ready.") {
 				return retry.RetryableError(fmt.Errorf("deleting load balancer failed with retryable error: %s", err))
 			} else {
@@ -531,28 +536,25 @@ func resourceVultrLoadBalancerDelete(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
-func waitForLBAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for load balancer (%s) to have %s of %s",
-		d.Id(), attribute, target)
+func waitForLBAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (*govultr.LoadBalancer, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for load balancer (%s) to have %s of %s",
+		d.Id(), attribute, target))
 
-	stateConf := &retry.StateChangeConf{
-		Pending:        pending,
-		Target:         []string{target},
-		Refresh:        newLBStateRefresh(ctx, d, meta, attribute),
-		Timeout:        60 * time.Minute,
-		Delay:          10 * time.Second,
-		MinTimeout:     5 * time.Second,
-		NotFoundChecks: 60,
+	wait := &stateWait[*govultr.LoadBalancer]{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: newLBStateRefresh(ctx, d, meta, attribute),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
-func newLBStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) retry.StateRefreshFunc { //nolint:lll
+func newLBStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) func() (*govultr.LoadBalancer, string, error) { //nolint:lll
 	client := meta.(*Client).govultrClient()
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Refreshing load balancer state")
+	return func() (*govultr.LoadBalancer, string, error) {
+		tflog.Info(ctx, "Refreshing load balancer state")
 
 		lb, _, err := client.LoadBalancer.Get(ctx, d.Id())
 		if err != nil {
@@ -560,7 +562,7 @@ func newLBStateRefresh(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 
 		if attr == "status" {
-			log.Printf("[INFO] The load balancer Status is %v", lb.Status)
+			tflog.Info(ctx, fmt.Sprintf("The load balancer Status is %v", lb.Status))
 			return lb, lb.Status, nil
 		}
 
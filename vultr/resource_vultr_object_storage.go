@@ -3,12 +3,12 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
 )
 
 func resourceVultrObjectStorage() *schema.Resource {
@@ -62,6 +62,9 @@ func resourceVultrObjectStorage() *schema.Resource {
 				Sensitive: true,
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+		},
 	}
 }
 
@@ -78,7 +81,7 @@ func resourceVultrObjectStorageCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(obj.ID)
 
-	if _, err = waitForObjAvailable(ctx, d, "active", []string{"pending"}, "status", meta); err != nil {
+	if _, err = waitForObjAvailable(ctx, d, "active", []string{"pending"}, "status", d.Timeout(schema.TimeoutCreate), meta); err != nil {
 		return diag.Errorf("error while waiting for Object Storage %s to be in a active state : %s", d.Id(), err)
 	}
 
@@ -88,9 +91,12 @@ func resourceVultrObjectStorageCreate(ctx context.Context, d *schema.ResourceDat
 func resourceVultrObjectStorageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	obj, _, err := client.ObjectStorage.Get(ctx, d.Id())
+	obj, resp, err := client.ObjectStorage.Get(ctx, d.Id())
 	if err != nil {
-		return diag.Errorf("error getting object storage account: %v", err)
+		if removeIfGone(ctx, d, resp, err) {
+			return nil
+		}
+		return apiErrorf(resp, err, "error getting object storage account: %v", err)
 	}
 
 	if err := d.Set("date_created", obj.DateCreated); err != nil {
@@ -139,46 +145,42 @@ func resourceVultrObjectStorageUpdate(ctx context.Context, d *schema.ResourceDat
 func resourceVultrObjectStorageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting Object storage subscription %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting Object storage subscription %s", d.Id()))
 
-	if err := client.ObjectStorage.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error deleting object storage subscription %s : %v", d.Id(), err)
+	if err := client.ObjectStorage.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error deleting object storage subscription %s : %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func waitForObjAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for Object Storage (%s) to have %s of %s",
-		d.Id(), attribute, target)
+func waitForObjAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (*govultr.ObjectStorage, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for Object Storage (%s) to have %s of %s",
+		d.Id(), attribute, target))
 
-	stateConf := &retry.StateChangeConf{
-		Pending:        pending,
-		Target:         []string{target},
-		Refresh:        newServerObjRefresh(ctx, d, meta, attribute),
-		Timeout:        60 * time.Minute,
-		Delay:          10 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 60,
+	wait := &stateWait[*govultr.ObjectStorage]{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: newServerObjRefresh(ctx, d, meta, attribute),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
-func newServerObjRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) retry.StateRefreshFunc { //nolint:lll
+func newServerObjRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}, attr string) func() (*govultr.ObjectStorage, string, error) { //nolint:lll
 	client := meta.(*Client).govultrClient()
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Creating Object Storage")
+	return func() (*govultr.ObjectStorage, string, error) {
+		tflog.Info(ctx, "Creating Object Storage")
 
 		obj, _, err := client.ObjectStorage.Get(ctx, d.Id())
 		if err != nil {
 			return nil, "", fmt.Errorf("error retrieving Object Store %s : %s", d.Id(), err)
 		}
 
-		log.Print(obj)
 		if attr == "status" {
-			log.Printf("[INFO] The Object Storage Status is %s", obj.Status)
+			tflog.Info(ctx, fmt.Sprintf("The Object Storage Status is %s", obj.Status))
 			return obj, obj.Status, nil
 		}
 		return nil, "", nil
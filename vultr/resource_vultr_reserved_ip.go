@@ -3,8 +3,6 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -73,7 +71,7 @@ func resourceVultrReservedIPCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(rip.ID)
-	log.Printf("[INFO] Reserved IP ID: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Reserved IP ID: %s", d.Id()))
 
 	if a, attachedOK := d.GetOk("instance_id"); attachedOK {
 		if err := client.ReservedIP.Attach(ctx, d.Id(), a.(string)); err != nil {
@@ -87,18 +85,16 @@ func resourceVultrReservedIPCreate(ctx context.Context, d *schema.ResourceData,
 func resourceVultrReservedIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	rip, _, err := client.ReservedIP.Get(ctx, d.Id())
+	rip, resp, err := client.ReservedIP.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid reserved-ip ID") {
-			tflog.Warn(ctx, fmt.Sprintf("Removing reserved-ip (%s) because it is gone", d.Id()))
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting Reserved IPs: %v", err)
+		return apiErrorf(resp, err, "error getting Reserved IPs: %v", err)
 	}
 
 	if rip == nil {
-		log.Printf("[WARN] Vultr Reserved IP (%s) not found", d.Id())
+		tflog.Warn(ctx, fmt.Sprintf("Vultr Reserved IP (%s) not found", d.Id()))
 		d.SetId("")
 		return nil
 	}
@@ -129,7 +125,7 @@ func resourceVultrReservedIPUpdate(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*Client).govultrClient()
 
 	if d.HasChange("instance_id") {
-		log.Printf("[INFO] Updating Reserved IP instance: %s", d.Id())
+		tflog.Info(ctx, fmt.Sprintf("Updating Reserved IP instance: %s", d.Id()))
 
 		old, newVal := d.GetChange("instance_id")
 
@@ -146,7 +142,7 @@ func resourceVultrReservedIPUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if d.HasChange("label") {
-		log.Printf("[INFO] Updating Reserved IP label: %s", d.Id())
+		tflog.Info(ctx, fmt.Sprintf("Updating Reserved IP label: %s", d.Id()))
 
 		req := &govultr.ReservedIPUpdateReq{
 			Label: govultr.StringToStringPtr(d.Get("label").(string)),
@@ -163,9 +159,9 @@ func resourceVultrReservedIPUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceVultrReservedIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting Reserved IP: %s", d.Id())
-	if err := client.ReservedIP.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error destroying Reserved IP (%s): %v", d.Id(), err)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Reserved IP: %s", d.Id()))
+	if err := client.ReservedIP.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying Reserved IP (%s): %v", d.Id(), err)
 	}
 
 	return nil
@@ -2,8 +2,9 @@ package vultr
 
 import (
 	"context"
-	"log"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vultr/govultr/v3"
@@ -53,7 +54,7 @@ func resourceVultrReverseIPV4Create(ctx context.Context, d *schema.ResourceData,
 		Reverse: d.Get("reverse").(string),
 	}
 
-	log.Printf("[INFO] Creating reverse IPv4")
+	tflog.Info(ctx, "Creating reverse IPv4")
 
 	if err := client.Instance.CreateReverseIPv4(ctx, instanceID, req); err != nil {
 		return diag.Errorf("error creating reverse IPv4: %v", err)
@@ -73,31 +74,24 @@ func resourceVultrReverseIPV4Read(ctx context.Context, d *schema.ResourceData, m
 
 	instanceID := d.Get("instance_id").(string)
 
-	var ReverseIPV4 *govultr.IPv4
-
-	options := &govultr.ListOptions{}
-	for {
-		ReverseIPV4s, meta, _, err := client.Instance.ListIPv4(ctx, instanceID, options)
-		if err != nil {
-			return diag.Errorf("error getting reverse IPv4s: %v, %v", err, instanceID)
-		}
-
-		for i := range ReverseIPV4s {
-			if ReverseIPV4s[i].IP == d.Id() {
-				ReverseIPV4 = &ReverseIPV4s[i]
-				break
-			}
+	ReverseIPV4s, err := listInstanceIPv4s(ctx, client, instanceID)
+	if err != nil {
+		if removeIfGone(ctx, d, nil, err) {
+			return nil
 		}
+		return apiErrorf(nil, err, "error getting reverse IPv4s: %v, %v", err, instanceID)
+	}
 
-		if ReverseIPV4 != nil {
+	var ReverseIPV4 *govultr.IPv4
+	for i := range ReverseIPV4s {
+		if ReverseIPV4s[i].IP == d.Id() {
+			ReverseIPV4 = &ReverseIPV4s[i]
 			break
 		}
+	}
 
-		if meta.Links.Next == "" {
-			return diag.Errorf("error getting reverse IPv4s: %v, %v", err, instanceID)
-		}
-
-		options.Cursor = meta.Links.Next
+	if ReverseIPV4 == nil {
+		return diag.Errorf("error getting reverse IPv4s: %s not found on instance %s", d.Id(), instanceID)
 	}
 
 	if err := d.Set("ip", ReverseIPV4.IP); err != nil {
@@ -121,9 +115,9 @@ func resourceVultrReverseIPV4Delete(ctx context.Context, d *schema.ResourceData,
 
 	instanceID := d.Get("instance_id").(string)
 
-	log.Printf("[INFO] Deleting reverse IPv4: %s", d.Id())
-	if err := client.Instance.DefaultReverseIPv4(ctx, instanceID, d.Id()); err != nil {
-		return diag.Errorf("error resetting reverse IPv4 (%s): %v", d.Id(), err)
+	tflog.Info(ctx, fmt.Sprintf("Deleting reverse IPv4: %s", d.Id()))
+	if err := client.Instance.DefaultReverseIPv4(ctx, instanceID, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error resetting reverse IPv4 (%s): %v", d.Id(), err)
 	}
 
 	return nil
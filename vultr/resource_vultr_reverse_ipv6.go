@@ -2,8 +2,9 @@ package vultr
 
 import (
 	"context"
-	"log"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vultr/govultr/v3"
@@ -61,9 +62,12 @@ func resourceVultrReverseIPV6Read(ctx context.Context, d *schema.ResourceData, m
 
 	reverseIPV6 := &govultr.ReverseIP{}
 
-	reverseIPv6s, _, err := client.Instance.ListReverseIPv6(ctx, instanceID)
+	reverseIPv6s, resp, err := client.Instance.ListReverseIPv6(ctx, instanceID)
 	if err != nil {
-		return diag.Errorf("error getting reverse IPv4s: %v, %v", err, instanceID)
+		if removeIfGone(ctx, d, resp, err) {
+			return nil
+		}
+		return apiErrorf(resp, err, "error getting reverse IPv4s: %v, %v", err, instanceID)
 	}
 
 	for i := range reverseIPv6s {
@@ -74,7 +78,7 @@ func resourceVultrReverseIPV6Read(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if reverseIPV6.IP == "" {
-		log.Printf("[WARN] Removing reverse IPv6 (%s) because it is gone", d.Id())
+		tflog.Warn(ctx, fmt.Sprintf("Removing reverse IPv6 (%s) because it is gone", d.Id()))
 		d.SetId("")
 		return nil
 	}
@@ -94,9 +98,9 @@ func resourceVultrReverseIPV6Delete(ctx context.Context, d *schema.ResourceData,
 
 	instanceID := d.Get("instance_id").(string)
 
-	log.Printf("[INFO] Deleting reverse IPv6: %s", d.Id())
-	if err := client.Instance.DeleteReverseIPv6(ctx, instanceID, d.Id()); err != nil {
-		return diag.Errorf("error destroying reverse IPv6 (%s): %v", d.Id(), err)
+	tflog.Info(ctx, fmt.Sprintf("Deleting reverse IPv6: %s", d.Id()))
+	if err := client.Instance.DeleteReverseIPv6(ctx, instanceID, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying reverse IPv6 (%s): %v", d.Id(), err)
 	}
 
 	return nil
@@ -3,12 +3,11 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vultr/govultr/v3"
 )
@@ -76,12 +75,12 @@ func resourceVultrSnapshotCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(snapshot.ID)
 
-	if _, err = waitForSnapshot(ctx, d, "complete", []string{"pending"}, "status", meta); err != nil {
+	if _, err = waitForSnapshot(ctx, d, "complete", []string{"pending"}, "status", d.Timeout(schema.TimeoutCreate), meta); err != nil {
 		return diag.Errorf(
 			"error while waiting for Snapshot %s to be completed: %s", d.Id(), err)
 	}
 
-	log.Printf("[INFO] Snapshot ID: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Snapshot ID: %s", d.Id()))
 
 	return resourceVultrSnapshotRead(ctx, d, meta)
 }
@@ -89,13 +88,16 @@ func resourceVultrSnapshotCreate(ctx context.Context, d *schema.ResourceData, me
 func resourceVultrSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	snapshot, _, err := client.Snapshot.Get(ctx, d.Id())
+	snapshot, resp, err := client.Snapshot.Get(ctx, d.Id())
 	if err != nil {
-		return diag.Errorf("error getting snapshots: %v", err)
+		if removeIfGone(ctx, d, resp, err) {
+			return nil
+		}
+		return apiErrorf(resp, err, "error getting snapshots: %v", err)
 	}
 
 	if snapshot == nil {
-		log.Printf("[WARN] Vultr snapshot (%s) not found", d.Id())
+		tflog.Warn(ctx, fmt.Sprintf("Vultr snapshot (%s) not found", d.Id()))
 		d.SetId("")
 		return nil
 	}
@@ -125,42 +127,39 @@ func resourceVultrSnapshotRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceVultrSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting snapshot: %s", d.Id())
-	if err := client.Snapshot.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error destroying snapshot (%s): %v", d.Id(), err)
+	tflog.Info(ctx, fmt.Sprintf("Deleting snapshot: %s", d.Id()))
+	if err := client.Snapshot.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying snapshot (%s): %v", d.Id(), err)
 	}
 
 	return nil
 }
 
-func waitForSnapshot(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
-	log.Printf(
-		"[INFO] Waiting for Snapshot (%s) to have %s of %s",
-		d.Id(), attribute, target)
-
-	stateConf := &retry.StateChangeConf{
-		Pending:        pending,
-		Target:         []string{target},
-		Refresh:        newSnapStateRefresh(d, meta),
-		Timeout:        60 * time.Minute,
-		Delay:          10 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 60,
+func waitForSnapshot(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (*govultr.Snapshot, error) { //nolint:lll
+	tflog.Info(ctx, fmt.Sprintf(
+		"Waiting for Snapshot (%s) to have %s of %s",
+		d.Id(), attribute, target))
+
+	wait := &stateWait[*govultr.Snapshot]{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: newSnapStateRefresh(ctx, d, meta),
+		Timeout: timeout,
 	}
 
-	return stateConf.WaitForStateContext(ctx)
+	return wait.wait(ctx)
 }
 
-func newSnapStateRefresh(d *schema.ResourceData, meta interface{}) retry.StateRefreshFunc {
+func newSnapStateRefresh(ctx context.Context, d *schema.ResourceData, meta interface{}) func() (*govultr.Snapshot, string, error) {
 	client := meta.(*Client).govultrClient()
-	return func() (interface{}, string, error) {
-		log.Printf("[INFO] Creating Snapshot")
-		snap, _, err := client.Snapshot.Get(context.Background(), d.Id())
+	return func() (*govultr.Snapshot, string, error) {
+		tflog.Info(ctx, "Creating Snapshot")
+		snap, _, err := client.Snapshot.Get(ctx, d.Id())
 		if err != nil {
 			return nil, "", fmt.Errorf("error retrieving Snapshot %s : %s", d.Id(), err)
 		}
 
-		log.Printf("[INFO] The SnapShot Status is %s", snap.Status)
+		tflog.Info(ctx, fmt.Sprintf("The SnapShot Status is %s", snap.Status))
 		return snap, snap.Status, nil
 	}
 }
@@ -2,8 +2,9 @@ package vultr
 
 import (
 	"context"
-	"log"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/vultr/govultr/v3"
 
@@ -66,7 +67,7 @@ func resourceVultrSnapshotFromURLCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	d.SetId(snapshot.ID)
-	log.Printf("[INFO] Snapshot ID: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Snapshot ID: %s", d.Id()))
 
 	return resourceVultrSnapshotRead(ctx, d, meta)
 }
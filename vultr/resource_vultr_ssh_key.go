@@ -3,8 +3,6 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,7 +52,7 @@ func resourceVultrSSHKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.SetId(key.ID)
-	log.Printf("[INFO] SSH Key ID: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("SSH Key ID: %s", d.Id()))
 
 	return resourceVultrSSHKeyRead(ctx, d, meta)
 }
@@ -62,14 +60,12 @@ func resourceVultrSSHKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 func resourceVultrSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	key, _, err := client.SSHKey.Get(ctx, d.Id())
+	key, resp, err := client.SSHKey.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid ssh key") {
-			tflog.Warn(ctx, fmt.Sprintf("Removing ssh key (%s) because it is gone", d.Id()))
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting SSH keys: %v", err)
+		return apiErrorf(resp, err, "error getting SSH keys: %v", err)
 	}
 
 	if err := d.Set("name", key.Name); err != nil {
@@ -98,7 +94,7 @@ func resourceVultrSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		key.SSHKey = d.Get("ssh_key").(string)
 	}
 
-	log.Printf("[INFO] Updating SSH Key: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Updating SSH Key: %s", d.Id()))
 	if err := client.SSHKey.Update(context.Background(), d.Id(), key); err != nil {
 		return diag.Errorf("error updating SSH key (%s): %v", d.Id(), err)
 	}
@@ -108,10 +104,10 @@ func resourceVultrSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceVultrSSHKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
-	log.Printf("[INFO] Deleting SSH Key: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting SSH Key: %s", d.Id()))
 
-	if err := client.SSHKey.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error destroying SSH key (%s): %v", d.Id(), err)
+	if err := client.SSHKey.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying SSH key (%s): %v", d.Id(), err)
 	}
 
 	return nil
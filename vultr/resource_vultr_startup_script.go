@@ -3,8 +3,6 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -67,7 +65,7 @@ func resourceVultrStartupScriptCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.SetId(s.ID)
-	log.Printf("[INFO] startup script ID: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("startup script ID: %s", d.Id()))
 
 	return resourceVultrStartupScriptRead(ctx, d, meta)
 }
@@ -75,14 +73,12 @@ func resourceVultrStartupScriptCreate(ctx context.Context, d *schema.ResourceDat
 func resourceVultrStartupScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	script, _, err := client.StartupScript.Get(ctx, d.Id())
+	script, resp, err := client.StartupScript.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid startup script ID") {
-			tflog.Warn(ctx, fmt.Sprintf("Removing startup script (%s) because it is gone", d.Id()))
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting startup script: %v", err)
+		return apiErrorf(resp, err, "error getting startup script: %v", err)
 	}
 
 	if err := d.Set("name", script.Name); err != nil {
@@ -114,7 +110,7 @@ func resourceVultrStartupScriptUpdate(ctx context.Context, d *schema.ResourceDat
 			Script: d.Get("script").(string),
 		}
 
-		log.Printf("[INFO] Updating startup script: %s", d.Id())
+		tflog.Info(ctx, fmt.Sprintf("Updating startup script: %s", d.Id()))
 		if err := client.StartupScript.Update(ctx, d.Id(), scriptReq); err != nil {
 			return diag.Errorf("Error updating startup script (%s): %v", d.Id(), err)
 		}
@@ -126,9 +122,9 @@ func resourceVultrStartupScriptUpdate(ctx context.Context, d *schema.ResourceDat
 func resourceVultrStartupScriptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting startup script: %s", d.Id())
-	if err := client.StartupScript.Delete(ctx, d.Id()); err != nil {
-		return diag.Errorf("error destroying startup script (%s): %v", d.Id(), err)
+	tflog.Info(ctx, fmt.Sprintf("Deleting startup script: %s", d.Id()))
+	if err := client.StartupScript.Delete(ctx, d.Id()); err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error destroying startup script (%s): %v", d.Id(), err)
 	}
 
 	return nil
@@ -3,8 +3,6 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -31,10 +29,13 @@ func resourceVultrUsers() *schema.Resource {
 				Required: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Sensitive: true,
-				Required:  true,
+				Type:         schema.TypeString,
+				Sensitive:    true,
+				Optional:     true,
+				ExactlyOneOf: []string{"password", "password_wo"},
 			},
+			"password_wo":         passwordWOSchema(),
+			"password_wo_version": passwordWOVersionSchema(),
 			"api_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -63,6 +64,14 @@ func resourceVultrUsersCreate(ctx context.Context, d *schema.ResourceData, meta
 		APIEnabled: &test,
 	}
 
+	if usesWriteOnlyPassword(d) {
+		password, diags := writeOnlyPassword(d)
+		if diags.HasError() {
+			return diags
+		}
+		userReq.Password = password
+	}
+
 	acl, aclOK := d.GetOk("acl")
 	a := acl.(*schema.Set).List()
 	var aclMap []string
@@ -90,14 +99,12 @@ func resourceVultrUsersCreate(ctx context.Context, d *schema.ResourceData, meta
 func resourceVultrUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	user, _, err := client.User.Get(ctx, d.Id())
+	user, resp, err := client.User.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid user") {
-			tflog.Warn(ctx, fmt.Sprintf("Removing user (%s) because it is gone", d.Id()))
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting user: %v", err)
+		return apiErrorf(resp, err, "error getting user: %v", err)
 	}
 
 	if err := d.Set("name", user.Name); err != nil {
@@ -132,6 +139,14 @@ func resourceVultrUsersUpdate(ctx context.Context, d *schema.ResourceData, meta
 		userReq.Password = d.Get("password").(string)
 	}
 
+	if d.HasChange("password_wo_version") {
+		password, diags := writeOnlyPassword(d)
+		if diags.HasError() {
+			return diags
+		}
+		userReq.Password = password
+	}
+
 	if d.HasChange("api_enabled") {
 		api := d.Get("api_enabled").(bool)
 		userReq.APIEnabled = &api
@@ -158,11 +173,11 @@ func resourceVultrUsersUpdate(ctx context.Context, d *schema.ResourceData, meta
 func resourceVultrUsersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting User %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting User %s", d.Id()))
 
 	err := client.User.Delete(ctx, d.Id())
-	if err != nil {
-		return diag.Errorf("error deleting user %s : %v", d.Id(), err)
+	if err != nil && !isNotFound(nil, err) {
+		return apiErrorf(nil, err, "error deleting user %s : %v", d.Id(), err)
 	}
 	return nil
 }
@@ -3,10 +3,10 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -73,7 +73,7 @@ func resourceVultrVPCCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	d.SetId(vpc.ID)
-	log.Printf("[INFO] VPC ID: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("VPC ID: %s", d.Id()))
 
 	return resourceVultrVPCRead(ctx, d, meta)
 }
@@ -81,14 +81,12 @@ func resourceVultrVPCCreate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceVultrVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	vpc, _, err := client.VPC.Get(ctx, d.Id())
+	vpc, resp, err := client.VPC.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid VPC ID") {
-			log.Printf("[WARN] Vultr VPC (%s) not found", d.Id())
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting VPC: %v", err)
+		return apiErrorf(resp, err, "error getting VPC: %v", err)
 	}
 
 	if err := d.Set("region", vpc.Region); err != nil {
@@ -123,12 +121,12 @@ func resourceVultrVPCUpdate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceVultrVPCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting VPC: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting VPC: %s", d.Id()))
 
 	retryErr := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete)-time.Minute, func() *retry.RetryError {
 		err := client.VPC.Delete(ctx, d.Id())
 
-		if err == nil {
+		if err == nil || isNotFound(nil, err) {
 			return nil
 		}
 
@@ -3,10 +3,10 @@ package vultr
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -80,7 +80,7 @@ func resourceVultrVPC2Create(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	d.SetId(vpc.ID)
-	log.Printf("[INFO] VPC 2.0 ID: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("VPC 2.0 ID: %s", d.Id()))
 
 	return resourceVultrVPC2Read(ctx, d, meta)
 }
@@ -88,14 +88,12 @@ func resourceVultrVPC2Create(ctx context.Context, d *schema.ResourceData, meta i
 func resourceVultrVPC2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	vpc, _, err := client.VPC2.Get(ctx, d.Id())
+	vpc, resp, err := client.VPC2.Get(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Invalid VPC 2.0 ID") {
-			log.Printf("[WARN] Vultr VPC 2.0 (%s) not found", d.Id())
-			d.SetId("")
+		if removeIfGone(ctx, d, resp, err) {
 			return nil
 		}
-		return diag.Errorf("error getting VPC 2.0: %v", err)
+		return apiErrorf(resp, err, "error getting VPC 2.0: %v", err)
 	}
 
 	if err := d.Set("region", vpc.Region); err != nil {
@@ -130,12 +128,12 @@ func resourceVultrVPC2Update(ctx context.Context, d *schema.ResourceData, meta i
 func resourceVultrVPC2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
-	log.Printf("[INFO] Deleting VPC 2.0: %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Deleting VPC 2.0: %s", d.Id()))
 
 	retryErr := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete)-time.Minute, func() *retry.RetryError {
 		err := client.VPC2.Delete(ctx, d.Id())
 
-		if err == nil {
+		if err == nil || isNotFound(nil, err) {
 			return nil
 		}
 
@@ -0,0 +1,255 @@
+package vultr
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRetryLimit          = 3
+	defaultRetryMinWait        = 500 * time.Millisecond
+	defaultMaxRetryWait        = 30 * time.Second
+	defaultEndpointConcurrency = 8
+)
+
+// RetryPolicy decides whether a request should be retried and how long to
+// wait before doing so
+type RetryPolicy interface {
+	// Retry is called after every attempt with the request and its response
+	// or transport error. attempt starts at 0 for the first retry.
+	Retry(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool)
+}
+
+// BackoffRetryPolicy retries rate limited, unavailable and failed requests
+// with exponential backoff and jitter. A Retry-After header on a 429 or 503
+// response is honoured as long as it is within MaxWait. Requests that aren't
+// idempotent, such as creates, are only retried when the API can't have
+// acted on them, so that a retry never creates a second instance.
+type BackoffRetryPolicy struct {
+	MaxRetries int
+	MinWait    time.Duration
+	MaxWait    time.Duration
+
+	// Jitter returns a value in [0, 1) and defaults to math/rand
+	Jitter func() float64
+}
+
+// Retry implements RetryPolicy
+func (p *BackoffRetryPolicy) Retry(
+	req *http.Request, resp *http.Response, err error, attempt int,
+) (time.Duration, bool) {
+	if attempt >= p.MaxRetries || !retryable(req, resp, err) {
+		return 0, false
+	}
+
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			// Retrying before the API is ready again only burns through
+			// the retries, so give up if the wait is too long
+			if wait > p.MaxWait {
+				return 0, false
+			}
+			return wait, true
+		}
+	}
+
+	wait := float64(p.MinWait) * math.Pow(2, float64(attempt))
+	if wait > float64(p.MaxWait) {
+		wait = float64(p.MaxWait)
+	}
+
+	jitter := p.Jitter
+	if jitter == nil {
+		jitter = rand.Float64
+	}
+
+	// Wait somewhere between half and all of the backoff so that parallel
+	// requests that were limited together don't retry together
+	return time.Duration(wait/2 + wait/2*jitter()), true
+}
+
+// retryable reports whether an attempt failed in a way that may succeed if
+// tried again. A request that isn't idempotent may have been carried out
+// even though it failed, so it is only retried when it was rate limited or
+// never reached the API.
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		var certErr *tls.CertificateVerificationError
+		var unknownAuthority x509.UnknownAuthorityError
+		var hostnameErr x509.HostnameError
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) ||
+			errors.As(err, &certErr) || errors.As(err, &unknownAuthority) || errors.As(err, &hostnameErr) {
+			return false
+		}
+		return idempotent(req.Method) || notSent(err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	return idempotent(req.Method) && resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented
+}
+
+// idempotent reports whether sending a request twice has the same effect as
+// sending it once
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// notSent reports whether a transport error happened while connecting, before
+// any of the request was sent
+func notSent(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP
+// date
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(v); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// retryTransport retries requests according to a RetryPolicy and caps the
+// number of requests in flight for each class of endpoint
+type retryTransport struct {
+	next        http.RoundTripper
+	policy      RetryPolicy
+	concurrency int
+
+	mu    sync.Mutex
+	slots map[string]chan struct{}
+}
+
+func newRetryTransport(next http.RoundTripper, policy RetryPolicy, concurrency int) *retryTransport {
+	return &retryTransport{
+		next:        next,
+		policy:      policy,
+		concurrency: concurrency,
+		slots:       map[string]chan struct{}{},
+	}
+}
+
+// endpointClass groups requests by the API collection they touch, so that
+// hundreds of DNS record or firewall rule calls queue behind each other
+// rather than behind unrelated resources
+func endpointClass(path string) string {
+	if i := strings.Index(path, "/v2/"); i >= 0 {
+		path = path[i+len("/v2/"):]
+	}
+	class, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	return class
+}
+
+func (t *retryTransport) acquire(ctx context.Context, class string) error {
+	if t.concurrency <= 0 {
+		return nil
+	}
+
+	t.mu.Lock()
+	slots, ok := t.slots[class]
+	if !ok {
+		slots = make(chan struct{}, t.concurrency)
+		t.slots[class] = slots
+	}
+	t.mu.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *retryTransport) release(class string) {
+	if t.concurrency <= 0 {
+		return
+	}
+
+	t.mu.Lock()
+	slots := t.slots[class]
+	t.mu.Unlock()
+	<-slots
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	class := endpointClass(req.URL.Path)
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req.Clone(ctx)
+		if body != nil {
+			attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		if err := t.acquire(ctx, class); err != nil {
+			return nil, err
+		}
+		resp, err := t.next.RoundTrip(attemptReq)
+		t.release(class)
+
+		wait, retry := t.policy.Retry(attemptReq, resp, err, attempt)
+		if !retry {
+			return resp, err
+		}
+
+		if resp != nil {
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
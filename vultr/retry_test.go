@@ -0,0 +1,247 @@
+package vultr
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *BackoffRetryPolicy {
+	return &BackoffRetryPolicy{
+		MaxRetries: 3,
+		MinWait:    time.Millisecond,
+		MaxWait:    2 * time.Second,
+	}
+}
+
+func TestRetryTransportHonoursRetryAfter(t *testing.T) {
+	var calls int32
+	var first, second time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"name":"www"}` {
+			t.Errorf("expected the request body on every attempt, got %q", body)
+		}
+
+		if atomic.AddInt32(&calls, 1) == 1 {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		second = time.Now()
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, testRetryPolicy(), 0)}
+	resp, err := client.Post(server.URL+"/v2/domains/example.com/records", "application/json", strings.NewReader(`{"name":"www"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated || calls != 2 {
+		t.Fatalf("expected a 201 after 2 calls, got %d after %d", resp.StatusCode, calls)
+	}
+	if waited := second.Sub(first); waited < time.Second {
+		t.Fatalf("expected to wait for Retry-After, waited %s", waited)
+	}
+}
+
+func TestRetryTransportGivesUp(t *testing.T) {
+	cases := map[string]struct {
+		method     string
+		status     int
+		retryAfter string
+		calls      int32
+	}{
+		"retry limit":          {method: http.MethodGet, status: http.StatusTooManyRequests, calls: 4},
+		"server error":         {method: http.MethodGet, status: http.StatusBadGateway, calls: 4},
+		"retry-after too long": {method: http.MethodGet, status: http.StatusServiceUnavailable, retryAfter: "60", calls: 1},
+		"not retryable":        {method: http.MethodGet, status: http.StatusNotFound, calls: 1},
+		"create rate limited":  {method: http.MethodPost, status: http.StatusTooManyRequests, calls: 4},
+		"create server error":  {method: http.MethodPost, status: http.StatusBadGateway, calls: 1},
+		"update server error":  {method: http.MethodPatch, status: http.StatusServiceUnavailable, calls: 1},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				if tc.retryAfter != "" {
+					w.Header().Set("Retry-After", tc.retryAfter)
+				}
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			req, _ := http.NewRequest(tc.method, server.URL+"/v2/instances", http.NoBody)
+			client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, testRetryPolicy(), 0)}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.status || calls != tc.calls {
+				t.Fatalf("expected %d after %d calls, got %d after %d", tc.status, tc.calls, resp.StatusCode, calls)
+			}
+		})
+	}
+}
+
+func TestRetryTransportCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/v2/instances", nil)
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, testRetryPolicy(), 0)}
+
+	start := time.Now()
+	if _, err := client.Do(req); err == nil {
+		t.Fatal("expected the cancelled context to stop the retries")
+	}
+	if time.Since(start) > 900*time.Millisecond {
+		t.Fatal("expected the retry wait to be interrupted by the context")
+	}
+}
+
+func TestRetryTransportConcurrency(t *testing.T) {
+	var inFlight, peak int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, testRetryPolicy(), 2)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL + "/v2/firewalls/abc/rules")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Fatalf("expected at most 2 concurrent firewall requests, saw %d", peak)
+	}
+}
+
+func TestBackoffRetryPolicy(t *testing.T) {
+	policy := &BackoffRetryPolicy{
+		MaxRetries: 10,
+		MinWait:    100 * time.Millisecond,
+		MaxWait:    time.Second,
+		Jitter:     func() float64 { return 1 },
+	}
+	get, _ := http.NewRequest(http.MethodGet, "https://api.vultr.com/v2/instances", http.NoBody)
+	limited := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+
+	for attempt, want := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		if wait, ok := policy.Retry(get, limited, nil, attempt); !ok || wait != want*time.Millisecond {
+			t.Fatalf("attempt %d: expected %s, got %s", attempt, want*time.Millisecond, wait)
+		}
+	}
+
+	policy.Jitter = func() float64 { return 0 }
+	if wait, _ := policy.Retry(get, limited, nil, 2); wait != 200*time.Millisecond {
+		t.Fatalf("expected jitter to halve the wait, got %s", wait)
+	}
+
+	if _, ok := policy.Retry(get, limited, nil, 10); ok {
+		t.Fatal("expected no retry past MaxRetries")
+	}
+}
+
+func TestBackoffRetryPolicyNonIdempotent(t *testing.T) {
+	policy := testRetryPolicy()
+	create, _ := http.NewRequest(http.MethodPost, "https://api.vultr.com/v2/instances", http.NoBody)
+
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	if _, ok := policy.Retry(create, nil, refused, 0); !ok {
+		t.Error("expected a create that never connected to be retried")
+	}
+
+	reset := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	if _, ok := policy.Retry(create, nil, reset, 0); ok {
+		t.Error("expected a create that may have reached the API not to be retried")
+	}
+
+	unavailable := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{"Retry-After": {"1"}}}
+	if _, ok := policy.Retry(create, unavailable, nil, 0); ok {
+		t.Error("expected a create that failed with a server error not to be retried")
+	}
+}
+
+func TestEndpointClass(t *testing.T) {
+	for path, want := range map[string]string{
+		"/v2/domains/example.com/records": "domains",
+		"/v2/instances":                   "instances",
+		"/proxy/v2/firewalls/abc/rules":   "firewalls",
+	} {
+		if got := endpointClass(path); got != want {
+			t.Errorf("%s: expected %s, got %s", path, want, got)
+		}
+	}
+}
+
+func TestConfigClientRetriesRateLimitedCalls(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"error":"Rate limit reached","status":429}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"account":{"name":"mock"}}`))
+	}))
+	defer server.Close()
+
+	config := Config{APIKey: "test-key", APIEndpoint: server.URL, RetryPolicy: testRetryPolicy()}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("error building client: %v", err)
+	}
+
+	account, _, err := client.govultrClient().Account.Get(context.Background())
+	if err != nil {
+		t.Fatalf("expected the rate limited call to be retried, got %v", err)
+	}
+	if account.Name != "mock" || calls != 3 {
+		t.Fatalf("unexpected account %+v after %d calls", account, calls)
+	}
+}
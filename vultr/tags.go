@@ -0,0 +1,280 @@
+package vultr
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultTagsSchema is the provider level default_tags block
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags added to every taggable resource managed by this provider",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The tags to add to each resource",
+				},
+			},
+		},
+	}
+}
+
+// ignoreTagsSchema is the provider level ignore_tags block
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags managed outside of Terraform that should not be reported as drift",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Ignore tags with these keys. The key of a tag is the part before the first `:` or `=`, or the whole tag",
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Ignore tags whose key starts with one of these prefixes",
+				},
+			},
+		},
+	}
+}
+
+// tagsAllSchema is the computed set of tags including the provider defaults
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "All tags on the resource, including those inherited from the provider default_tags block",
+	}
+}
+
+// expandDefaultTags reads the tags from the provider default_tags block
+func expandDefaultTags(v []interface{}) []string {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	tags := setToStrings(v[0].(map[string]interface{})["tags"].(*schema.Set))
+	slices.Sort(tags)
+	return tags
+}
+
+// ignoreTagsConfig holds the provider ignore_tags block
+type ignoreTagsConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// expandIgnoreTags reads the provider ignore_tags block
+func expandIgnoreTags(v []interface{}) *ignoreTagsConfig {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	m := v[0].(map[string]interface{})
+	return &ignoreTagsConfig{
+		Keys:        setToStrings(m["keys"].(*schema.Set)),
+		KeyPrefixes: setToStrings(m["key_prefixes"].(*schema.Set)),
+	}
+}
+
+// tagKey returns the key of a `key:value` or `key=value` tag, or the whole
+// tag if it has no value
+func tagKey(tag string) string {
+	if i := strings.IndexAny(tag, ":="); i >= 0 {
+		return tag[:i]
+	}
+	return tag
+}
+
+func (c *ignoreTagsConfig) enabled() bool {
+	return c != nil && (len(c.Keys) > 0 || len(c.KeyPrefixes) > 0)
+}
+
+func (c *ignoreTagsConfig) ignored(tag string) bool {
+	if c == nil {
+		return false
+	}
+
+	key := tagKey(tag)
+	if slices.Contains(c.Keys, key) {
+		return true
+	}
+	for _, prefix := range c.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// drop returns the tags that are not ignored
+func (c *ignoreTagsConfig) drop(tags []string) []string {
+	out := []string{}
+	for _, t := range tags {
+		if !c.ignored(t) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// matching returns the tags that are ignored
+func (c *ignoreTagsConfig) matching(tags []string) []string {
+	var out []string
+	for _, t := range tags {
+		if c.ignored(t) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func setToStrings(set *schema.Set) []string {
+	var out []string
+	for _, v := range set.List() {
+		out = append(out, v.(string))
+	}
+	return out
+}
+
+// mergeTags returns the resource tags followed by any default tags the
+// resource doesn't already carry
+func mergeTags(tags, defaults []string) []string {
+	merged := append([]string{}, tags...)
+	for _, t := range defaults {
+		if !slices.Contains(merged, t) {
+			merged = append(merged, t)
+		}
+	}
+	return merged
+}
+
+// stripDefaultTags removes the provider default tags from the tags returned
+// by the API, unless the resource configures them itself
+func stripDefaultTags(tags, configured, defaults []string) []string {
+	out := []string{}
+	for _, t := range tags {
+		if slices.Contains(defaults, t) && !slices.Contains(configured, t) {
+			continue
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+// defaultTags returns the provider default tags, tolerating a nil meta
+// during validation
+func defaultTags(meta interface{}) []string {
+	if client, ok := meta.(*Client); ok && client != nil {
+		return client.defaultTags
+	}
+	return nil
+}
+
+// ignoreTags returns the provider ignore_tags configuration, if any
+func ignoreTags(meta interface{}) *ignoreTagsConfig {
+	if client, ok := meta.(*Client); ok && client != nil {
+		return client.ignoreTags
+	}
+	return nil
+}
+
+// resourceTagsWithDefaults returns the tags to send to the API for a
+// resource with a `tags` set
+func resourceTagsWithDefaults(d *schema.ResourceData, meta interface{}) []string {
+	return mergeTags(setToStrings(d.Get("tags").(*schema.Set)), defaultTags(meta))
+}
+
+// setResourceTags stores the API tags as `tags_all` and hides the provider
+// default tags from `tags`. Ignored tags are left out of both.
+func setResourceTags(d *schema.ResourceData, tags []string, meta interface{}) error {
+	tags = ignoreTags(meta).drop(tags)
+	configured := setToStrings(d.Get("tags").(*schema.Set))
+	if err := d.Set("tags", stripDefaultTags(tags, configured, defaultTags(meta))); err != nil {
+		return err
+	}
+	return d.Set("tags_all", tags)
+}
+
+// customizeDiffTagsAll plans `tags_all` from the configured tags and the
+// provider default tags so that a change to either shows up as an update
+func customizeDiffTagsAll(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	return d.SetNew("tags_all", mergeTags(setToStrings(d.Get("tags").(*schema.Set)), defaultTags(meta)))
+}
+
+// databaseTagSeparator joins the default tags into the single tag a managed
+// database supports
+const databaseTagSeparator = ","
+
+// databaseTagWithDefaults folds the provider default tags into a database tag
+func databaseTagWithDefaults(tag string, defaults []string) string {
+	var tags []string
+	if tag != "" {
+		tags = append(tags, tag)
+	}
+	return strings.Join(mergeTags(tags, defaults), databaseTagSeparator)
+}
+
+// splitDatabaseTag splits a database tag built by databaseTagWithDefaults
+func splitDatabaseTag(tag string) []string {
+	if tag == "" {
+		return []string{}
+	}
+	return strings.Split(tag, databaseTagSeparator)
+}
+
+// dropIgnoredDatabaseTags removes ignored tags from a database tag
+func dropIgnoredDatabaseTags(tag string, ignore *ignoreTagsConfig) string {
+	if !ignore.enabled() {
+		return tag
+	}
+	return strings.Join(ignore.drop(splitDatabaseTag(tag)), databaseTagSeparator)
+}
+
+// keepIgnoredDatabaseTags carries the ignored parts of the current database
+// tag over into the tag being set
+func keepIgnoredDatabaseTags(tag, current string, ignore *ignoreTagsConfig) string {
+	if !ignore.enabled() {
+		return tag
+	}
+	return strings.Join(mergeTags(splitDatabaseTag(tag), ignore.matching(splitDatabaseTag(current))), databaseTagSeparator)
+}
+
+// stripDatabaseDefaultTags recovers the configured database tag from the tag
+// returned by the API
+func stripDatabaseDefaultTags(tag, configured string, defaults []string) string {
+	if len(defaults) == 0 || tag == databaseTagWithDefaults(configured, defaults) {
+		return configured
+	}
+	return tag
+}
+
+// customizeDiffDatabaseTagsAll plans `tags_all` for a managed database
+func customizeDiffDatabaseTagsAll(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tag") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	return d.SetNew("tags_all", splitDatabaseTag(databaseTagWithDefaults(d.Get("tag").(string), defaultTags(meta))))
+}
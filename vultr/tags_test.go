@@ -0,0 +1,104 @@
+package vultr
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestMergeAndStripTags(t *testing.T) {
+	defaults := []string{"env:prod", "team:infra"}
+
+	merged := mergeTags([]string{"web", "env:prod"}, defaults)
+	if want := []string{"web", "env:prod", "team:infra"}; !reflect.DeepEqual(merged, want) {
+		t.Fatalf("expected %v, got %v", want, merged)
+	}
+
+	// env:prod is configured on the resource so it stays in tags
+	stripped := stripDefaultTags(merged, []string{"web", "env:prod"}, defaults)
+	if want := []string{"web", "env:prod"}; !reflect.DeepEqual(stripped, want) {
+		t.Fatalf("expected %v, got %v", want, stripped)
+	}
+
+	stripped = stripDefaultTags(merged, nil, defaults)
+	if want := []string{"web"}; !reflect.DeepEqual(stripped, want) {
+		t.Fatalf("expected %v, got %v", want, stripped)
+	}
+}
+
+func TestDatabaseTagWithDefaults(t *testing.T) {
+	defaults := []string{"env:prod", "team:infra"}
+
+	cases := []struct {
+		tag, want string
+		all       []string
+	}{
+		{tag: "", want: "env:prod,team:infra", all: []string{"env:prod", "team:infra"}},
+		{tag: "billing", want: "billing,env:prod,team:infra", all: []string{"billing", "env:prod", "team:infra"}},
+		{tag: "env:prod", want: "env:prod,team:infra", all: []string{"env:prod", "team:infra"}},
+	}
+
+	for _, tc := range cases {
+		got := databaseTagWithDefaults(tc.tag, defaults)
+		if got != tc.want {
+			t.Fatalf("expected tag %q, got %q", tc.want, got)
+		}
+		if all := splitDatabaseTag(got); !reflect.DeepEqual(all, tc.all) {
+			t.Fatalf("expected tags_all %v, got %v", tc.all, all)
+		}
+		if configured := stripDatabaseDefaultTags(got, tc.tag, defaults); configured != tc.tag {
+			t.Fatalf("expected configured tag %q, got %q", tc.tag, configured)
+		}
+	}
+
+	if got := databaseTagWithDefaults("billing", nil); got != "billing" {
+		t.Fatalf("expected the tag to be unchanged without defaults, got %q", got)
+	}
+	if got := stripDatabaseDefaultTags("changed-elsewhere", "billing", defaults); got != "changed-elsewhere" {
+		t.Fatalf("expected drift to be reported, got %q", got)
+	}
+}
+
+func TestProviderDefaultTags(t *testing.T) {
+	p := Provider()
+	raw := map[string]interface{}{
+		"api_key":                     "test-key",
+		"skip_credentials_validation": true,
+		"default_tags": []interface{}{
+			map[string]interface{}{"tags": []interface{}{"team:infra", "env:prod"}},
+		},
+	}
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatalf("error configuring provider: %v", diags)
+	}
+
+	if got, want := p.Meta().(*Client).defaultTags, []string{"env:prod", "team:infra"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected default tags %v, got %v", want, got)
+	}
+}
+
+func TestIgnoreTags(t *testing.T) {
+	ignore := &ignoreTagsConfig{Keys: []string{"cost-center"}, KeyPrefixes: []string{"finops"}}
+
+	tags := []string{"web", "cost-center:42", "cost-centers", "finops-owner=alice", "finops"}
+	if got, want := ignore.drop(tags), []string{"web", "cost-centers"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if got, want := ignore.matching(tags), []string{"cost-center:42", "finops-owner=alice", "finops"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	var disabled *ignoreTagsConfig
+	if disabled.enabled() || len(disabled.drop(tags)) != len(tags) {
+		t.Fatal("expected a nil config to ignore nothing")
+	}
+
+	if got := dropIgnoredDatabaseTags("billing,finops:1", ignore); got != "billing" {
+		t.Fatalf("expected ignored database tags to be dropped, got %q", got)
+	}
+	if got := keepIgnoredDatabaseTags("billing,env:prod", "old,finops:1", ignore); got != "billing,env:prod,finops:1" {
+		t.Fatalf("expected ignored database tags to be kept, got %q", got)
+	}
+}
@@ -0,0 +1,87 @@
+package vultr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// apiKeyRefreshInterval is how long a key read from a file or command is
+// used before it is read again, so that long applies pick up rotated keys
+const apiKeyRefreshInterval = 5 * time.Minute
+
+// apiKeyCommandTimeout bounds how long api_key_command may run
+const apiKeyCommandTimeout = 30 * time.Second
+
+// apiKeyTokenSource reads the API key from an external source every time the
+// previous token expires
+type apiKeyTokenSource struct {
+	read     func() (string, error)
+	interval time.Duration
+}
+
+// Token implements oauth2.TokenSource
+func (s *apiKeyTokenSource) Token() (*oauth2.Token, error) {
+	key, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	if key == "" {
+		return nil, fmt.Errorf("api key is empty")
+	}
+
+	return &oauth2.Token{
+		AccessToken: key,
+		Expiry:      time.Now().Add(s.interval),
+	}, nil
+}
+
+// newAPIKeyFileTokenSource re-reads the API key from path
+func newAPIKeyFileTokenSource(path string, interval time.Duration) oauth2.TokenSource {
+	return oauth2.ReuseTokenSource(nil, &apiKeyTokenSource{
+		interval: interval,
+		read: func() (string, error) {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return "", fmt.Errorf("error reading api_key_file: %v", err)
+			}
+			return strings.TrimSpace(string(data)), nil
+		},
+	})
+}
+
+// newAPIKeyCommandTokenSource re-runs command through the shell and uses its
+// standard output as the API key
+func newAPIKeyCommandTokenSource(command string, interval time.Duration) oauth2.TokenSource {
+	return oauth2.ReuseTokenSource(nil, &apiKeyTokenSource{
+		interval: interval,
+		read: func() (string, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), apiKeyCommandTimeout)
+			defer cancel()
+
+			var cmd *exec.Cmd
+			if runtime.GOOS == "windows" {
+				cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+			} else {
+				cmd = exec.CommandContext(ctx, "sh", "-c", command)
+			}
+
+			var stderr bytes.Buffer
+			cmd.Stderr = &stderr
+
+			out, err := cmd.Output()
+			if err != nil {
+				return "", fmt.Errorf("error running api_key_command: %v: %s", err, strings.TrimSpace(stderr.String()))
+			}
+			return strings.TrimSpace(string(out)), nil
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package vultr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAPIKeyFileTokenSourceRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vultr-api-key")
+	if err := os.WriteFile(path, []byte("first\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// A zero interval expires every token immediately so each call re-reads
+	src := newAPIKeyFileTokenSource(path, 0)
+
+	token, err := src.Token()
+	if err != nil || token.AccessToken != "first" {
+		t.Fatalf("expected the key from the file, got %v %v", token, err)
+	}
+
+	if err := os.WriteFile(path, []byte("rotated"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err = src.Token()
+	if err != nil || token.AccessToken != "rotated" {
+		t.Fatalf("expected the rotated key, got %v %v", token, err)
+	}
+
+	if err := os.WriteFile(path, []byte("  \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.Token(); err == nil {
+		t.Fatal("expected an error for an empty key file")
+	}
+}
+
+func TestAPIKeyCommandTokenSource(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+
+	token, err := newAPIKeyCommandTokenSource("printf 'from-helper\\n'", apiKeyRefreshInterval).Token()
+	if err != nil || token.AccessToken != "from-helper" {
+		t.Fatalf("expected the key printed by the command, got %v %v", token, err)
+	}
+
+	_, err = newAPIKeyCommandTokenSource("echo denied >&2; exit 3", apiKeyRefreshInterval).Token()
+	if err == nil || !strings.Contains(err.Error(), "denied") {
+		t.Fatalf("expected the command's stderr in the error, got %v", err)
+	}
+}
+
+func TestProviderAPIKeySources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer file-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"Invalid API token.","status":401}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"account":{"name":"mock"}}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "vultr-api-key")
+	if err := os.WriteFile(path, []byte("file-key"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	raw := map[string]interface{}{
+		"api_key_file": path,
+		"api_endpoint": server.URL,
+	}
+	if diags := Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatalf("error configuring provider from api_key_file: %v", diags)
+	}
+
+	raw["api_key"] = "static-key"
+	if diags := Provider().Validate(terraform.NewResourceConfigRaw(raw)); !diags.HasError() {
+		t.Fatal("expected api_key and api_key_file to conflict")
+	}
+
+	raw = map[string]interface{}{
+		"api_key_file": filepath.Join(t.TempDir(), "missing"),
+		"api_endpoint": server.URL,
+	}
+	if diags := Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); !diags.HasError() {
+		t.Fatal("expected a missing api_key_file to fail configure")
+	}
+}
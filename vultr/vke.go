@@ -2,6 +2,7 @@ package vultr
 
 import (
 	"encoding/base64"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -123,15 +124,30 @@ func nodePoolSchema(isNodePool bool) map[string]*schema.Schema {
 	return s
 }
 
-func getCertsFromKubeConfig(kubeconfig string) (ca string, cert string, key string, err error) {
+// DecodeKubeConfig decodes a kubeconfig as returned, base64 encoded, by the
+// API
+func DecodeKubeConfig(kubeconfig string) (*KubeConfig, error) {
 	decodedKC, err := base64.StdEncoding.DecodeString(kubeconfig)
 	if err != nil {
-		return "", "", "", err
+		return nil, err
 	}
 
 	var kc KubeConfig
 
 	err = yaml.Unmarshal(decodedKC, &kc)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(kc.Clusters) == 0 || len(kc.Users) == 0 {
+		return nil, fmt.Errorf("kubeconfig has no cluster or user")
+	}
+
+	return &kc, nil
+}
+
+func getCertsFromKubeConfig(kubeconfig string) (ca string, cert string, key string, err error) {
+	kc, err := DecodeKubeConfig(kubeconfig)
 	if err != nil {
 		return "", "", "", err
 	}
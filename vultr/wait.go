@@ -0,0 +1,102 @@
+package vultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// The defaults are variables so that tests against the fake API, where
+// objects settle immediately, don't have to wait
+var (
+	defaultWaitDelay    = 10 * time.Second
+	defaultPollInterval = 5 * time.Second
+)
+
+// stateWait polls Refresh until the state it reports is one of Target
+type stateWait[T any] struct {
+	// Pending states keep the wait going. When empty, any state that is not
+	// a target or failure state does.
+	Pending []string
+	Target  []string
+
+	// Failure states are terminal, so the wait ends as soon as one is seen
+	// instead of when the timeout expires
+	Failure []string
+
+	// Refresh fetches the object and returns it along with its state
+	Refresh func() (T, string, error)
+
+	// Timeout is normally the d.Timeout of the operation that waits, so that
+	// a timeouts block on the resource applies
+	Timeout time.Duration
+
+	// Delay is how long to wait before the first refresh and PollInterval how
+	// long to wait between refreshes
+	Delay        time.Duration
+	PollInterval time.Duration
+}
+
+// wait blocks until the target state is reached and returns the object from
+// the last refresh
+func (w *stateWait[T]) wait(ctx context.Context) (T, error) {
+	var zero T
+
+	ctx, cancel := context.WithTimeout(ctx, w.Timeout)
+	defer cancel()
+
+	delay := w.Delay
+	if delay == 0 {
+		delay = defaultWaitDelay
+	}
+
+	interval := w.PollInterval
+	if interval == 0 {
+		interval = defaultPollInterval
+	}
+
+	last := ""
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return zero, w.doneError(ctx.Err(), last)
+		case <-timer.C:
+		}
+
+		obj, state, err := w.Refresh()
+		if err != nil {
+			return zero, err
+		}
+		last = state
+
+		switch {
+		case slices.Contains(w.Target, state):
+			return obj, nil
+		case slices.Contains(w.Failure, state):
+			return obj, fmt.Errorf("reached failure state %q while waiting for %q", state, w.Target)
+		case len(w.Pending) > 0 && !slices.Contains(w.Pending, state):
+			return obj, fmt.Errorf("unexpected state %q, wanted target %q", state, w.Target)
+		}
+
+		timer.Reset(interval)
+	}
+}
+
+// doneError describes why the context of a wait ended: a timeout when its
+// deadline passed, or a cancellation, such as an interrupted run, otherwise
+func (w *stateWait[T]) doneError(err error, last string) error {
+	reason := "timeout"
+	if !errors.Is(err, context.DeadlineExceeded) {
+		reason = "cancelled"
+	}
+
+	if last == "" {
+		return fmt.Errorf("%s while waiting for state to become %q: %w", reason, w.Target, err)
+	}
+	return fmt.Errorf("%s while waiting for state to become %q (last state: %q): %w", reason, w.Target, last, err)
+}
@@ -0,0 +1,105 @@
+package vultr
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeObject struct {
+	calls int
+}
+
+// fakeRefresh reports each of states in turn and then keeps reporting the
+// last one
+func fakeRefresh(states ...string) func() (*fakeObject, string, error) {
+	obj := &fakeObject{}
+	return func() (*fakeObject, string, error) {
+		state := states[min(obj.calls, len(states)-1)]
+		obj.calls++
+		return obj, state, nil
+	}
+}
+
+func testStateWait(refresh func() (*fakeObject, string, error)) *stateWait[*fakeObject] {
+	return &stateWait[*fakeObject]{
+		Pending:      []string{"pending", "installing"},
+		Target:       []string{"active"},
+		Failure:      []string{"Error"},
+		Refresh:      refresh,
+		Timeout:      time.Second,
+		Delay:        time.Millisecond,
+		PollInterval: time.Millisecond,
+	}
+}
+
+func TestStateWaitTarget(t *testing.T) {
+	obj, err := testStateWait(fakeRefresh("pending", "installing", "active")).wait(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj.calls != 3 {
+		t.Fatalf("expected 3 refreshes, got %d", obj.calls)
+	}
+}
+
+func TestStateWaitFailure(t *testing.T) {
+	start := time.Now()
+	_, err := testStateWait(fakeRefresh("pending", "Error")).wait(context.Background())
+	if err == nil || !strings.Contains(err.Error(), `"Error"`) {
+		t.Fatalf("expected the failure state in the error, got %v", err)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Fatal("expected a failure state to end the wait early")
+	}
+}
+
+func TestStateWaitUnexpectedState(t *testing.T) {
+	_, err := testStateWait(fakeRefresh("pending", "suspended")).wait(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "unexpected state") {
+		t.Fatalf("expected an unexpected state error, got %v", err)
+	}
+
+	w := testStateWait(fakeRefresh("suspended", "resizing", "active"))
+	w.Pending = nil
+	if _, err := w.wait(context.Background()); err != nil {
+		t.Fatalf("expected any state to be pending when none are listed, got %v", err)
+	}
+}
+
+func TestStateWaitTimeout(t *testing.T) {
+	w := testStateWait(fakeRefresh("pending"))
+	w.Timeout = 50 * time.Millisecond
+
+	_, err := w.wait(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.HasPrefix(err.Error(), "timeout") ||
+		!strings.Contains(err.Error(), `last state: "pending"`) {
+		t.Fatalf("expected a timeout with the last state, got %v", err)
+	}
+}
+
+func TestStateWaitRefreshError(t *testing.T) {
+	wantErr := errors.New("boom")
+	w := testStateWait(func() (*fakeObject, string, error) {
+		return nil, "", wantErr
+	})
+
+	if _, err := w.wait(context.Background()); !errors.Is(err, wantErr) {
+		t.Fatalf("expected the refresh error, got %v", err)
+	}
+}
+
+func TestStateWaitCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	w := testStateWait(fakeRefresh("active"))
+	w.Delay = time.Hour
+
+	_, err := w.wait(ctx)
+	if !errors.Is(err, context.Canceled) || !strings.HasPrefix(err.Error(), "cancelled") {
+		t.Fatalf("expected a cancelled context to stop the wait with a cancellation, got %v", err)
+	}
+}
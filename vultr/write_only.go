@@ -0,0 +1,55 @@
+package vultr
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// passwordWOSchema is a write-only alternative to the password argument of a
+// resource, which Terraform sends on apply but never stores in state or plans
+func passwordWOSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: []string{"password"},
+		RequiredWith:  []string{"password_wo_version"},
+		Description:   "The password, which is not stored in state. Requires Terraform 1.11 or later",
+	}
+}
+
+// passwordWOVersionSchema is stored in place of password_wo, so that changing
+// it can trigger a rotation
+func passwordWOVersionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		RequiredWith: []string{"password_wo"},
+		Description:  "Change this to update the password to the current value of password_wo",
+	}
+}
+
+// writeOnlyPassword returns password_wo, which is only available from the raw
+// config of the current apply
+func writeOnlyPassword(d *schema.ResourceData) (string, diag.Diagnostics) {
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath("password_wo"))
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if !v.Type().Equals(cty.String) || v.IsNull() || !v.IsKnown() {
+		return "", nil
+	}
+	return v.AsString(), nil
+}
+
+// usesWriteOnlyPassword reports whether the password is managed through
+// password_wo, in which case the password returned by the API isn't stored
+func usesWriteOnlyPassword(d *schema.ResourceData) bool {
+	_, ok := d.GetOk("password_wo_version")
+	return ok
+}
@@ -0,0 +1,60 @@
+package vultr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/vultr/govultr/v3"
+)
+
+func TestDatabaseUserWriteOnlyPassword(t *testing.T) {
+	p, client := testFakeProvider(t)
+
+	database, _, err := client.Database.Create(context.Background(), &govultr.DatabaseCreateReq{
+		DatabaseEngine: "pg",
+		Region:         "ewr",
+		Plan:           "vultr-dbaas-startup-cc-1-55-2",
+		Label:          "write-only",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ty := p.ResourcesMap["vultr_database_user"].CoreConfigSchema().ImpliedType()
+	config := map[string]cty.Value{
+		"database_id":         cty.StringVal(database.ID),
+		"username":            cty.StringVal("app"),
+		"password_wo":         cty.StringVal("first-secret"),
+		"password_wo_version": cty.NumberIntVal(1),
+	}
+
+	state := testApplyResource(t, p, "vultr_database_user", cty.NullVal(ty), config)
+	assertPassword := func(want string) {
+		t.Helper()
+
+		user, _, err := client.Database.GetUser(context.Background(), database.ID, "app")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if user.Password != want {
+			t.Errorf("expected the API to have password %q, got %q", want, user.Password)
+		}
+		if got := state.GetAttr("password"); !got.IsNull() && got.AsString() != "" {
+			t.Errorf("expected the password to be left out of state, got %q", got.AsString())
+		}
+		if got := state.GetAttr("password_wo"); !got.IsNull() {
+			t.Errorf("expected password_wo to be left out of state, got %#v", got)
+		}
+	}
+	assertPassword("first-secret")
+
+	// A new password is only sent when the version changes
+	config["password_wo"] = cty.StringVal("second-secret")
+	state = testApplyResource(t, p, "vultr_database_user", state, config)
+	assertPassword("first-secret")
+
+	config["password_wo_version"] = cty.NumberIntVal(2)
+	state = testApplyResource(t, p, "vultr_database_user", state, config)
+	assertPassword("second-secret")
+}